@@ -19,6 +19,7 @@ func NewAnteHandler(
 	signModeHandler signing.SignModeHandler,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
 	channelKeeper *ibckeeper.Keeper,
+	appVersion func() uint64,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		// Wraps the panic with the string format of the transaction
@@ -30,6 +31,9 @@ func NewAnteHandler(
 		ante.NewExtensionOptionsDecorator(nil),
 		// Ensure the tx passes ValidateBasic.
 		ante.NewValidateBasicDecorator(),
+		// Ensure the tx's messages are supported at the current app version.
+		// Contract: must be called before all decorators that change state.
+		NewMsgVersioningDecorator(appVersion),
 		// Ensure the tx has not reached a height timeout.
 		ante.NewTxTimeoutHeightDecorator(),
 		// Ensure the tx memo <= max memo characters.
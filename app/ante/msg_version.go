@@ -0,0 +1,60 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/x/upgrade"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// minAppVersions are the app versions from which the messages added after v1
+// are accepted, keyed by message type URL.
+var minAppVersions = map[string]uint64{
+	sdk.MsgTypeURL(&upgrade.MsgSignalVersion{}):                      v2.Version,
+	sdk.MsgTypeURL(&blobstreamtypes.MsgSubmitAttestationSignature{}): v2.Version,
+}
+
+// MsgVersioningDecorator rejects a tx with a message that isn't supported at
+// the current app version. It must run before any decorator that changes
+// state: a node at an older app version can't decode the message, so the fees
+// and the sequence of the tx must be left untouched for both to agree on the
+// app hash.
+type MsgVersioningDecorator struct {
+	appVersion func() uint64
+}
+
+func NewMsgVersioningDecorator(appVersion func() uint64) MsgVersioningDecorator {
+	return MsgVersioningDecorator{appVersion: appVersion}
+}
+
+// AnteHandle implements the AnteHandler interface. It ensures that every
+// message of the tx, including the ones executed through authz, is supported
+// at the current app version.
+func (d MsgVersioningDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d MsgVersioningDecorator) checkMsgs(msgs []sdk.Msg) error {
+	appVersion := d.appVersion()
+	for _, msg := range msgs {
+		if minVersion, ok := minAppVersions[sdk.MsgTypeURL(msg)]; ok && appVersion < minVersion {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is only supported from app version %d, the current app version is %d", sdk.MsgTypeURL(msg), minVersion, appVersion)
+		}
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			execMsgs, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(execMsgs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/ante"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgVersioningDecorator(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	addr := testnode.RandomAddress().(types.AccAddress)
+	msgSend := banktypes.NewMsgSend(addr, addr, types.NewCoins(types.NewCoin("utia", types.NewInt(10))))
+	msgSignal := upgrade.NewMsgSignalVersion(types.ValAddress(addr), 3)
+	msgExec := authz.NewMsgExec(addr, []types.Msg{msgSignal})
+	msgAttestationSignature := blobstreamtypes.NewMsgSubmitAttestationSignature(types.ValAddress(addr), 1, make([]byte, blobstreamtypes.EVMSignatureLength))
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		msg        []types.Msg
+		expErr     bool
+	}{
+		{
+			name:       "msg from v1 at v1",
+			appVersion: 1,
+			msg:        []types.Msg{msgSend},
+			expErr:     false,
+		},
+		{
+			name:       "signal at v1",
+			appVersion: 1,
+			msg:        []types.Msg{msgSend, msgSignal},
+			expErr:     true,
+		},
+		{
+			name:       "signal executed through authz at v1",
+			appVersion: 1,
+			msg:        []types.Msg{&msgExec},
+			expErr:     true,
+		},
+		{
+			name:       "attestation signature at v1",
+			appVersion: 1,
+			msg:        []types.Msg{msgAttestationSignature},
+			expErr:     true,
+		},
+		{
+			name:       "attestation signature at v2",
+			appVersion: 2,
+			msg:        []types.Msg{msgAttestationSignature},
+			expErr:     false,
+		},
+		{
+			name:       "signal at v2",
+			appVersion: 2,
+			msg:        []types.Msg{msgSend, msgSignal},
+			expErr:     false,
+		},
+		{
+			name:       "signal executed through authz at v2",
+			appVersion: 2,
+			msg:        []types.Msg{&msgExec},
+			expErr:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := ante.NewMsgVersioningDecorator(func() uint64 { return tc.appVersion })
+			anteHandler := types.ChainAnteDecorators(decorator)
+			builder := encCfg.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg...))
+			_, err := anteHandler(types.Context{}, builder.GetTx(), false)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 	"github.com/celestiaorg/celestia-app/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -80,12 +82,16 @@ import (
 	tmjson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/celestiaorg/celestia-app/app/ante"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/square"
 	blobmodule "github.com/celestiaorg/celestia-app/x/blob"
 	blobmodulekeeper "github.com/celestiaorg/celestia-app/x/blob/keeper"
 	blobmoduletypes "github.com/celestiaorg/celestia-app/x/blob/types"
@@ -200,6 +206,18 @@ type App struct {
 	txConfig          client.TxConfig
 
 	invCheckPeriod uint
+	// erasureWorkers is the number of goroutines used to erasure code the data
+	// square and compute its row and column roots, see FlagErasureWorkers.
+	erasureWorkers int
+	// edsCache caches the extended data squares computed when processing
+	// proposals.
+	edsCache *da.EDSCache
+	// packingStrategy decides which transactions are included in proposals,
+	// see FlagPackingStrategy.
+	packingStrategy square.PackingStrategy
+	// logProposalStats logs the layout of the square of each prepared
+	// proposal, see FlagLogProposalStats.
+	logProposalStats bool
 
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
@@ -284,11 +302,23 @@ func New(
 		interfaceRegistry: interfaceRegistry,
 		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
+		erasureWorkers:    cast.ToInt(appOpts.Get(FlagErasureWorkers)),
+		edsCache:          da.NewEDSCache(da.DefaultEDSCacheSize),
+		logProposalStats:  cast.ToBool(appOpts.Get(FlagLogProposalStats)),
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
 	}
 
+	packingStrategy, err := square.NewPackingStrategy(cast.ToString(appOpts.Get(FlagPackingStrategy)), app.txFee, app.txSigners)
+	if err != nil {
+		panic(err)
+	}
+	if cast.ToBool(appOpts.Get(FlagMinimizePadding)) {
+		packingStrategy = square.MinimizePaddingStrategy{Strategy: packingStrategy, Signers: app.txSigners}
+	}
+	app.packingStrategy = packingStrategy
+
 	app.ParamsKeeper = initParamsKeeper(appCodec, cdc, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
 
 	// set the BaseApp's parameter store
@@ -334,7 +364,7 @@ func New(
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
-	app.UpgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], upgradeSchedule)
+	app.UpgradeKeeper = upgrade.NewKeeper(keys[upgrade.StoreKey], upgradeSchedule, chainIDFromAppOptions(appOpts), &stakingKeeper, bApp.AppVersion)
 
 	app.BlobstreamKeeper = *bsmodulekeeper.NewKeeper(
 		appCodec,
@@ -360,7 +390,8 @@ func New(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...).
+		WithMinAppVersion(v2.Version, app.V2Params()...)
 
 	// register the proposal types
 	govRouter := oldgovtypes.NewRouter()
@@ -524,12 +555,17 @@ func New(
 	)
 
 	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
-	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.NewShareInclusionProofQuerier(app.edsCache))
+	app.QueryRouter().AddRoute(proof.NamespaceQueryPath, proof.QueryNamespaceProof)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	// the upgrade module isn't part of the module manager so its services are
+	// registered separately
+	upgrade.RegisterMsgServer(app.MsgServiceRouter(), app.UpgradeKeeper)
+	upgrade.RegisterQueryServer(app.GRPCQueryRouter(), app.UpgradeKeeper)
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -548,6 +584,7 @@ func New(
 		encodingConfig.TxConfig.SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
+		app.AppVersion,
 	))
 	app.setPostHanders()
 
@@ -575,17 +612,35 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	res := app.mm.EndBlock(ctx, req)
 	if app.UpgradeKeeper.ShouldUpgrade() {
+		currentAppVersion := app.AppVersion()
 		newAppVersion := app.UpgradeKeeper.GetNextAppVersion()
 		app.SetProtocolVersion(newAppVersion)
-		_, err := app.mm.RunMigrations(ctx, app.configurator, GetModuleVersion(newAppVersion))
+		// the modules are migrated from the versions they have in the current
+		// app version
+		_, err := app.mm.RunMigrations(ctx, app.configurator, GetModuleVersion(currentAppVersion))
 		if err != nil {
 			panic(err)
 		}
 		app.UpgradeKeeper.MarkUpgradeComplete()
+		app.UpgradeKeeper.ResetTally(ctx, newAppVersion)
 	}
 	return res
 }
 
+// Commit commits the block and evicts the extended data squares cached below
+// the committed height.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	app.edsCache.Prune(app.LastBlockHeight())
+	return res
+}
+
+// EDSCache returns the cache of the extended data squares computed when
+// processing proposals.
+func (app *App) EDSCache() *da.EDSCache {
+	return app.edsCache
+}
+
 // InitChainer application update at chain initialization
 func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
@@ -595,7 +650,11 @@ func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.Res
 	if req.ConsensusParams != nil && req.ConsensusParams.Version != nil {
 		app.SetProtocolVersion(req.ConsensusParams.Version.AppVersion)
 	}
-	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
+	// the header of InitChain doesn't carry the app version so it is set for
+	// the modules to initialize their genesis at the version of the chain.
+	header := ctx.BlockHeader()
+	header.Version.App = app.AppVersion()
+	return app.mm.InitGenesis(ctx.WithBlockHeader(header), app.appCodec, genesisState)
 }
 
 // LoadHeight loads a particular height
@@ -702,6 +761,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 
 	// Register the
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	if err := upgrade.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, upgrade.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -712,6 +774,9 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.BaseApp.GRPCQueryRouter(), app.interfaceRegistry, nil)
+	// the blob query service reads blobs from committed blocks so it needs
+	// access to the node rather than to the application state.
+	blobmoduletypes.RegisterBlobQueryServer(app.BaseApp.GRPCQueryRouter(), blobmodulekeeper.NewBlobQueryServer(clientCtx, app.txConfig.TxDecoder()))
 }
 
 func (app *App) RegisterNodeService(clientCtx client.Context) {
@@ -744,6 +809,17 @@ func (*App) BlockedParams() [][2]string {
 	}
 }
 
+// V2Params are params that were added in v2 and can only be changed via
+// governance from app version 2.
+func (*App) V2Params() [][2]string {
+	return [][2]string{
+		// blobstream.AttestationExpiryTime
+		{bsmoduletypes.ModuleName, string(bsmoduletypes.ParamsStoreKeyAttestationExpiryTime)},
+		// blobstream.SignificantPowerDifferenceThreshold
+		{bsmoduletypes.ModuleName, string(bsmoduletypes.ParamsStoreKeySignificantPowerDifferenceThreshold)},
+	}
+}
+
 // initParamsKeeper init params keeper and its subspaces
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -779,3 +855,22 @@ func extractRegisters(m module.BasicManager, soloRegisters ...encoding.ModuleReg
 	}
 	return s
 }
+
+// chainIDFromAppOptions returns the chain ID of the node from the app options,
+// falling back to the chain ID of the genesis file in the home directory. The
+// base app only learns the chain ID in InitChain, which isn't called when the
+// node restarts. It returns an empty string if neither is available.
+func chainIDFromAppOptions(appOpts servertypes.AppOptions) string {
+	if chainID := cast.ToString(appOpts.Get(flags.FlagChainID)); chainID != "" {
+		return chainID
+	}
+	homeDir := cast.ToString(appOpts.Get(flags.FlagHome))
+	if homeDir == "" {
+		return ""
+	}
+	genDoc, err := tmtypes.GenesisDocFromFile(filepath.Join(homeDir, "config", "genesis.json"))
+	if err != nil {
+		return ""
+	}
+	return genDoc.ChainID
+}
@@ -21,6 +21,17 @@ func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSq
 	return da.ExtendShares(shares.ToBytes(dataSquare))
 }
 
+// ExtendBlock extends the given block data into a data square for a given app
+// version like the ExtendBlock function, but reuses the extended data square
+// computed when processing the proposal of the block at height if it is still
+// cached and its data root is dataRoot.
+func (app *App) ExtendBlock(height int64, dataRoot []byte, data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
+	if eds, _, ok := app.edsCache.Get(height, dataRoot); ok {
+		return eds, nil
+	}
+	return ExtendBlock(data, appVersion)
+}
+
 // EmptyBlock returns true if the given block data is considered empty by the
 // application at a given version.
 func IsEmptyBlock(data coretypes.Data, _ uint64) bool {
@@ -1,18 +1,38 @@
 package app
 
 import (
+	"math"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/app/ante"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/x/upgrade"
 	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	abci "github.com/tendermint/tendermint/abci/types"
 	core "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
+// FlagPackingStrategy is the flag to set the strategy used to decide which
+// transactions are included in proposals when they don't all fit in the
+// square, see square.NewPackingStrategy. Defaults to preserving the
+// prioritized order of the mempool.
+const FlagPackingStrategy = "packing-strategy"
+
+// FlagMinimizePadding is the flag to reorder the blob transactions of proposals
+// to reduce the padding between blobs, see square.MinimizePaddingStrategy.
+const FlagMinimizePadding = "minimize-padding"
+
+// FlagErasureWorkers is the flag to set the number of goroutines used to
+// erasure code the data square and compute the data availability header when
+// preparing and processing proposals. If it isn't positive, GOMAXPROCS
+// goroutines are used.
+const FlagErasureWorkers = "erasure-workers"
+
 // PrepareProposal fulfills the celestia-core version of the ABCI interface by
 // preparing the proposal block data. The square size is determined by first
 // estimating it via the size of the passed block data. Then, this method
@@ -40,6 +60,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
+		app.AppVersion,
 	)
 
 	var txs [][]byte
@@ -63,7 +84,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		// TODO: this would be improved if we only attempted the upgrade in the first round of the
 		// height to still allow transactions to pass through without being delayed from trying
 		// to coordinate the upgrade height
-		if newVersion, ok := app.UpgradeKeeper.ShouldProposeUpgrade(req.ChainId, req.Height); ok && newVersion > app.GetBaseApp().AppVersion() {
+		if newVersion, ok := app.UpgradeKeeper.ShouldProposeUpgrade(sdkCtx, req.ChainId, req.Height); ok && IsSupported(newVersion) && newVersion > app.GetBaseApp().AppVersion() {
 			upgradeTx, err := upgrade.NewMsgVersionChange(app.txConfig, newVersion)
 			if err != nil {
 				panic(err)
@@ -84,15 +105,21 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 
 	// build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := square.Build(txs, app.GetBaseApp().AppVersion(), app.GovSquareSizeUpperBound(sdkCtx))
+	builder, err := square.NewBuilder(app.GovSquareSizeUpperBound(sdkCtx), app.GetBaseApp().AppVersion())
 	if err != nil {
 		panic(err)
 	}
+	txs = app.packingStrategy.Pack(builder, txs)
+	dataSquare, err := builder.Export()
+	if err != nil {
+		panic(err)
+	}
+	app.recordProposalStats(req.Height, builder)
 
 	// erasure the data square which we use to create the data root.
 	// Note: uses the nmt wrapper to construct the tree.
 	// checkout pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	eds, err := da.ExtendSharesParallel(shares.ToBytes(dataSquare), app.erasureWorkers)
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
@@ -104,7 +131,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 
 	// create the new data root by creating the data availability header (merkle
 	// roots of each row and col of the erasure data).
-	dah, err := da.NewDataAvailabilityHeader(eds)
+	dah, err := da.NewDataAvailabilityHeaderParallel(eds, app.erasureWorkers)
 	if err != nil {
 		app.Logger().Error(
 			"failure to create new data availability header",
@@ -132,3 +159,39 @@ func sizeOf(txs [][]byte) int {
 	}
 	return size
 }
+
+// txFee returns the fee paid in the bond denom by the transaction, or zero if
+// the transaction can't be decoded.
+func (app *App) txFee(tx []byte) uint64 {
+	sdkTx, err := app.txConfig.TxDecoder()(tx)
+	if err != nil {
+		return 0
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		return math.MaxUint64
+	}
+	return fee.Uint64()
+}
+
+// txSigners returns the accounts whose sequence is incremented by the
+// transaction, or none if the transaction can't be decoded.
+func (app *App) txSigners(tx []byte) []string {
+	sdkTx, err := app.txConfig.TxDecoder()(tx)
+	if err != nil {
+		return nil
+	}
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+	signers := make([]string, 0)
+	for _, signer := range sigTx.GetSigners() {
+		signers = append(signers, signer.String())
+	}
+	return signers
+}
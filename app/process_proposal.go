@@ -45,6 +45,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
 		app.IBCKeeper,
+		app.AppVersion,
 	)
 	sdkCtx := app.NewProposalContext(req.Header)
 
@@ -93,6 +94,13 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 					return reject()
 				}
 
+				// from v2, validators holding the signal threshold of the
+				// voting power must have signalled the app version
+				if app.UpgradeKeeper.RequiresSignal() && !app.UpgradeKeeper.HasReachedThreshold(sdkCtx, appVersion) {
+					logInvalidPropBlock(app.Logger(), req.Header, fmt.Sprintf("block proposes an app version %d that hasn't reached the signal threshold", appVersion))
+					return reject()
+				}
+
 				// we don't need to pass this message through the ante handler
 				continue
 			}
@@ -144,13 +152,13 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
-	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	eds, err := da.ExtendSharesParallel(shares.ToBytes(dataSquare), app.erasureWorkers)
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
 		return reject()
 	}
 
-	dah, err := da.NewDataAvailabilityHeader(eds)
+	dah, err := da.NewDataAvailabilityHeaderParallel(eds, app.erasureWorkers)
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to create new data availability header", err)
 		return reject()
@@ -162,6 +170,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		logInvalidPropBlock(app.Logger(), req.Header, "proposed data root differs from calculated data root")
 		return reject()
 	}
+	app.edsCache.Add(req.Header.Height, eds, dah)
 
 	return accept()
 }
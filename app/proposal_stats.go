@@ -0,0 +1,59 @@
+package app
+
+import (
+	"encoding/json"
+
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// FlagLogProposalStats is the flag to log the layout of the square of each
+// prepared proposal as a JSON encoded debug line.
+const FlagLogProposalStats = "log-proposal-stats"
+
+// proposalStats describes the square of a prepared proposal.
+type proposalStats struct {
+	Height int64 `json:"height"`
+	square.Stats
+	PaddingShares  int     `json:"padding_shares"`
+	BlobEfficiency float64 `json:"blob_efficiency"`
+}
+
+// recordProposalStats exposes the layout of the square built for the proposal
+// at the provided height as telemetry gauges and, if enabled, logs it.
+func (app *App) recordProposalStats(height int64, builder *square.Builder) {
+	stats, err := builder.Stats()
+	if err != nil {
+		app.Logger().Error("failure to compute the stats of a proposal", "height", height, "error", err.Error())
+		return
+	}
+
+	telemetry.SetGauge(float32(stats.SquareSize), "prepare_proposal", "square_size")
+	telemetry.SetGauge(float32(stats.Txs), "prepare_proposal", "txs")
+	telemetry.SetGauge(float32(stats.PFBs), "prepare_proposal", "pfbs")
+	telemetry.SetGauge(float32(stats.Blobs), "prepare_proposal", "blobs")
+	telemetry.SetGauge(float32(stats.DroppedTxs), "prepare_proposal", "dropped_txs")
+	telemetry.SetGauge(float32(stats.DroppedPFBs), "prepare_proposal", "dropped_pfbs")
+	telemetry.SetGauge(float32(stats.NamespacePaddingShares), "prepare_proposal", "padding_shares", "namespace")
+	telemetry.SetGauge(float32(stats.ReservedPaddingShares), "prepare_proposal", "padding_shares", "reserved")
+	telemetry.SetGauge(float32(stats.TailPaddingShares), "prepare_proposal", "padding_shares", "tail")
+	telemetry.SetGauge(float32(stats.BlobBytes), "prepare_proposal", "blob_bytes")
+	telemetry.SetGauge(float32(stats.BlobShares), "prepare_proposal", "blob_shares")
+	telemetry.SetGauge(float32(stats.BlobEfficiency()), "prepare_proposal", "blob_efficiency")
+	telemetry.SetGauge(float32(len(stats.Namespaces)), "prepare_proposal", "namespaces")
+
+	if !app.logProposalStats {
+		return
+	}
+	bz, err := json.Marshal(proposalStats{
+		Height:         height,
+		Stats:          stats,
+		PaddingShares:  stats.PaddingShares(),
+		BlobEfficiency: stats.BlobEfficiency(),
+	})
+	if err != nil {
+		app.Logger().Error("failure to encode the stats of a proposal", "height", height, "error", err.Error())
+		return
+	}
+	app.Logger().Debug("prepared proposal", "height", height, "stats", string(bz))
+}
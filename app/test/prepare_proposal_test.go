@@ -18,9 +18,13 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/blob"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/pkg/user"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 )
 
 func TestPrepareProposalPutsPFBsAtEnd(t *testing.T) {
@@ -216,3 +220,53 @@ func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfa
 	}
 	return infos
 }
+
+// TestPrepareProposalPackingStrategiesKeepSignerOrder checks that the packing
+// strategies that reorder blob transactions keep the order of the blob
+// transactions of each signer so that the proposal is accepted.
+func TestPrepareProposalPackingStrategiesKeepSignerOrder(t *testing.T) {
+	for _, strategy := range []string{square.OrderPreservingStrategyName, square.FeePerShareStrategyName, square.KnapsackStrategyName} {
+		t.Run(strategy, func(t *testing.T) {
+			accnts := testfactory.GenerateAccounts(2)
+			opts := testnode.DefaultAppOptions()
+			opts.Set(app.FlagPackingStrategy, strategy)
+			testApp, kr := testutil.SetupTestAppWithGenesisValSetAndOptions(app.DefaultConsensusParams(), opts, accnts...)
+			encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+			infos := queryAccountInfo(testApp, accnts, kr)
+
+			var txs [][]byte
+			for i, acc := range accnts {
+				signer, err := user.NewSigner(kr, nil, testfactory.GetAddress(kr, acc), encCfg.TxConfig, testutil.ChainID, infos[i].AccountNum, infos[i].Sequence)
+				require.NoError(t, err)
+				// each signer pays more per share for its later transactions
+				// so that sorting by fee per share alone would invert them
+				for j, size := range []int{5000, 2000, 100} {
+					b := blob.New(appns.RandomBlobNamespace(), tmrand.Bytes(size), appconsts.DefaultShareVersion)
+					gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(size)})
+					tx, err := signer.CreatePayForBlob([]*blob.Blob{b}, user.SetGasLimitAndFee(gasLimit, float64(i+1)*float64(j+1)))
+					require.NoError(t, err)
+					txs = append(txs, tx)
+				}
+			}
+
+			height := testApp.LastBlockHeight() + 1
+			resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+				BlockData: &tmproto.Data{Txs: txs},
+				ChainId:   testutil.ChainID,
+				Height:    height,
+				Time:      time.Now(),
+			})
+			require.Equal(t, len(txs), len(resp.BlockData.Txs))
+
+			res := testApp.ProcessProposal(abci.RequestProcessProposal{
+				BlockData: resp.BlockData,
+				Header: tmproto.Header{
+					Height:   height,
+					DataHash: resp.BlockData.Hash,
+					ChainID:  testutil.ChainID,
+				},
+			})
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Result)
+		})
+	}
+}
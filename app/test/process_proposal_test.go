@@ -19,6 +19,7 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/blob"
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/pkg/user"
@@ -325,6 +326,95 @@ func TestProcessProposal(t *testing.T) {
 	}
 }
 
+func TestProcessProposalCachesEDS(t *testing.T) {
+	enc := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	accounts := testfactory.GenerateAccounts(2)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+	blobTxs := blobfactory.ManyMultiBlobTx(
+		t, enc, kr, testutil.ChainID, accounts, infos,
+		blobfactory.NestedBlobs(
+			t,
+			appns.RandomBlobNamespaces(tmrand.NewRand(), 2),
+			[][]int{{1000}, {3000}},
+		),
+	)
+
+	processBlock := func(height int64, txs [][]byte) *tmproto.Data {
+		resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+			BlockData: &tmproto.Data{Txs: txs},
+			ChainId:   testutil.ChainID,
+			Height:    height,
+			Time:      time.Now(),
+		})
+		res := testApp.ProcessProposal(abci.RequestProcessProposal{
+			BlockData: resp.BlockData,
+			Header: tmproto.Header{
+				Height:   height,
+				DataHash: resp.BlockData.Hash,
+				ChainID:  testutil.ChainID,
+			},
+		})
+		require.True(t, res.IsOK())
+		return resp.BlockData
+	}
+	commitBlock := func(height int64) {
+		testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height, ChainID: testutil.ChainID}})
+		testApp.EndBlock(abci.RequestEndBlock{Height: height})
+		testApp.Commit()
+	}
+
+	height := testApp.LastBlockHeight() + 1
+	blockData := processBlock(height, blobTxs)
+	eds, _, ok := testApp.EDSCache().Get(height, blockData.Hash)
+	require.True(t, ok)
+
+	data, err := coretypes.DataFromProto(blockData)
+	require.NoError(t, err)
+	expected, err := app.ExtendBlock(data, testApp.AppVersion())
+	require.NoError(t, err)
+	assert.True(t, expected.Equals(eds))
+
+	// the app extends the block from the cache: the block data isn't used
+	// while the extended data square of the data root is cached
+	got, err := testApp.ExtendBlock(height, blockData.Hash, coretypes.Data{}, testApp.AppVersion())
+	require.NoError(t, err)
+	assert.True(t, expected.Equals(got))
+	// and is extended again otherwise
+	got, err = testApp.ExtendBlock(height, tmrand.Bytes(32), data, testApp.AppVersion())
+	require.NoError(t, err)
+	assert.True(t, expected.Equals(got))
+
+	// the share inclusion proofs of the block are created from the cached
+	// extended data square
+	block := tmproto.Block{
+		Header: tmproto.Header{Height: height, DataHash: blockData.Hash},
+		Data:   *blockData,
+	}
+	block.Header.Version.App = testApp.AppVersion()
+	blockBytes, err := block.Marshal()
+	require.NoError(t, err)
+	res := testApp.Query(abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/0/1", proof.ShareInclusionQueryPath),
+		Data: blockBytes,
+	})
+	require.True(t, res.IsOK(), res.Log)
+	var pShareProof tmproto.ShareProof
+	require.NoError(t, pShareProof.Unmarshal(res.Value))
+	shareProof, err := coretypes.ShareProofFromProto(pShareProof)
+	require.NoError(t, err)
+	require.NoError(t, shareProof.Validate(blockData.Hash))
+
+	// the extended data square is kept until the next height is committed
+	commitBlock(height)
+	_, _, ok = testApp.EDSCache().Get(height, blockData.Hash)
+	assert.True(t, ok)
+	processBlock(height+1, nil)
+	commitBlock(height + 1)
+	_, _, ok = testApp.EDSCache().Get(height, blockData.Hash)
+	assert.False(t, ok)
+}
+
 func calculateNewDataHash(t *testing.T, txs [][]byte) []byte {
 	dataSquare, err := square.Construct(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
 	require.NoError(t, err)
@@ -2,16 +2,22 @@ package app_test
 
 import (
 	"context"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/cmd/celestia-appd/cmd"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/test/util/genesis"
 	"github.com/celestiaorg/celestia-app/test/util/testnode"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestBlobstreamRPCQueries(t *testing.T) {
@@ -19,7 +25,9 @@ func TestBlobstreamRPCQueries(t *testing.T) {
 		t.Skip("skipping blobstream integration test in short mode.")
 	}
 	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
-	cfg := testnode.DefaultConfig().WithModifiers(genesis.SetDataCommitmentWindow(ecfg.Codec, 100))
+	cfg := testnode.DefaultConfig().
+		WithModifiers(genesis.SetDataCommitmentWindow(ecfg.Codec, 100)).
+		WithAppCreator(v2AppCreator)
 
 	cctx, _, _ := testnode.NewNetwork(t, cfg)
 
@@ -112,4 +120,35 @@ func TestBlobstreamRPCQueries(t *testing.T) {
 			assert.NoError(t, err)
 		})
 	}
+
+	t.Run("data root tuple root matches the data commitment RPC", func(t *testing.T) {
+		resp, err := queryClient.DataRootTupleRoot(
+			context.Background(),
+			&types.QueryDataRootTupleRootRequest{Nonce: 2},
+		)
+		require.NoError(t, err)
+		dc := resp.DataCommitment
+		expected, err := cctx.Client.DataCommitment(context.Background(), dc.BeginBlock, dc.EndBlock)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(expected.DataCommitment), resp.DataRootTupleRoot)
+
+		height := dc.BeginBlock + 5
+		proofResp, err := queryClient.DataRootTupleInclusionProof(
+			context.Background(),
+			&types.QueryDataRootTupleInclusionProofRequest{Nonce: 2, Height: height},
+		)
+		require.NoError(t, err)
+		expectedProof, err := cctx.Client.DataRootInclusionProof(context.Background(), height, dc.BeginBlock, dc.EndBlock)
+		require.NoError(t, err)
+		assert.Equal(t, *expectedProof.Proof.ToProto(), proofResp.Proof)
+	})
+}
+
+// v2AppCreator creates the app at v2 because celestia-core does not pass the
+// app version of the genesis to InitChain, and the data roots are only saved
+// from v2.
+func v2AppCreator(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	a := cmd.NewAppServer(logger, db, traceStore, appOpts)
+	a.(*app.App).SetProtocolVersion(v2.Version)
+	return a
 }
@@ -48,14 +48,17 @@ var (
 		"genutil":      genutil.AppModule{}.ConsensusVersion(),
 		"capability":   capability.AppModule{}.ConsensusVersion(),
 		"blob":         blob.AppModule{}.ConsensusVersion(),
-		"qgb":          blobstream.AppModule{}.ConsensusVersion(),
-		"ibc":          ibc.AppModule{}.ConsensusVersion(),
-		"transfer":     transfer.AppModule{}.ConsensusVersion(),
+		// the blobstream params were extended in consensus version 3, and the
+		// EVM address indexes were added in version 4, which are only used
+		// from v2 on
+		"qgb":      2,
+		"ibc":      ibc.AppModule{}.ConsensusVersion(),
+		"transfer": transfer.AppModule{}.ConsensusVersion(),
 	}
 
-	// There is currently complete parity between v1 and v2 modules, but this
-	// will likely change
-	v2moduleVersionMap = v1moduleVersionMap
+	v2moduleVersionMap = withModuleVersions(v1moduleVersionMap, module.VersionMap{
+		"qgb": blobstream.AppModule{}.ConsensusVersion(),
+	})
 )
 
 const DefaultInitialVersion = v1.Version
@@ -82,6 +85,19 @@ func IsSupported(version uint64) bool {
 	return false
 }
 
+// withModuleVersions returns a copy of versionMap in which the versions of the
+// modules in overrides are replaced.
+func withModuleVersions(versionMap, overrides module.VersionMap) module.VersionMap {
+	merged := make(module.VersionMap, len(versionMap))
+	for moduleName, version := range versionMap {
+		merged[moduleName] = version
+	}
+	for moduleName, version := range overrides {
+		merged[moduleName] = version
+	}
+	return merged
+}
+
 func GetModuleVersion(appVersion uint64) module.VersionMap {
 	switch appVersion {
 	case v1.Version:
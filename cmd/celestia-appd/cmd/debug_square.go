@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagSquareFormat = "format"
+
+	squareFormatText = "text"
+	squareFormatJSON = "json"
+	squareFormatGrid = "grid"
+)
+
+// squareReport is the JSON output of the debug square command.
+type squareReport struct {
+	Height     int64                  `json:"height"`
+	AppVersion uint64                 `json:"app_version"`
+	SquareSize int                    `json:"square_size"`
+	DataRoot   tmbytes.HexBytes       `json:"data_root"`
+	DataHash   tmbytes.HexBytes       `json:"data_hash"`
+	Shares     []square.ShareInfo     `json:"shares"`
+	Violations []square.BlobViolation `json:"violations"`
+}
+
+func debugSquareCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square <height|block.json>",
+		Short: "Inspect the shares of the data square of a block",
+		Long: "Inspect the shares of the data square of a block.\n" +
+			"The square is rebuilt from the transactions of the block, either fetched from the node at the given height " +
+			"or read from a JSON file containing a block or the response of the block RPC. For each share, it prints the " +
+			"namespace, share version, sequence start indicator, sequence length, reserved bytes, padding kind and the " +
+			"index in the block of the transaction and the blob the share belongs to. Blobs that violate the non-interactive default rules are flagged " +
+			"and the data root of the rebuilt square is compared to the data hash of the block.\n",
+		Example: "celestia-appd debug square 100 --node tcp://localhost:26657\n" +
+			"celestia-appd debug square block.json --format grid",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(flagSquareFormat)
+			if err != nil {
+				return err
+			}
+			if format != squareFormatText && format != squareFormatJSON && format != squareFormatGrid {
+				return fmt.Errorf("unsupported format %q, must be one of %s, %s or %s", format, squareFormatText, squareFormatJSON, squareFormatGrid)
+			}
+
+			block, err := loadBlock(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			report, err := inspectBlock(block)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			switch format {
+			case squareFormatJSON:
+				bz, err := json.MarshalIndent(report, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(out, string(bz))
+				return err
+			case squareFormatGrid:
+				printSquareGrid(out, report)
+			default:
+				printSquareTable(out, report)
+			}
+			printSquareSummary(out, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSquareFormat, squareFormatText, fmt.Sprintf("Output format: %s, %s or %s", squareFormatText, squareFormatJSON, squareFormatGrid))
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	return cmd
+}
+
+// loadBlock fetches the block at the height given by arg from the node or, if
+// arg isn't a height, reads it from the JSON file at arg.
+func loadBlock(cmd *cobra.Command, arg string) (*types.Block, error) {
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return readBlockFile(arg)
+	}
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, err
+	}
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.Block(cmd.Context(), &height)
+	if err != nil {
+		return nil, fmt.Errorf("fetching block %d: %w", height, err)
+	}
+	return res.Block, nil
+}
+
+// readBlockFile reads a block from a JSON file containing either a block, the
+// result of the block RPC or the full JSON-RPC response.
+func readBlockFile(path string) (*types.Block, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rpcResponse struct {
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(bz, &rpcResponse); err == nil && len(rpcResponse.Result) != 0 {
+		bz = rpcResponse.Result
+	}
+	var result coretypes.ResultBlock
+	if err := tmjson.Unmarshal(bz, &result); err == nil && result.Block != nil {
+		return result.Block, nil
+	}
+	var block types.Block
+	if err := tmjson.Unmarshal(bz, &block); err != nil {
+		return nil, fmt.Errorf("decoding block from %s: %w", path, err)
+	}
+	return &block, nil
+}
+
+// inspectBlock rebuilds the data square of the block and describes its shares.
+func inspectBlock(block *types.Block) (squareReport, error) {
+	appVersion := block.Header.Version.App
+	dataSquare, infos, violations, err := square.Inspect(block.Data.Txs.ToSliceOfBytes(), appVersion, appconsts.SquareSizeUpperBound(appVersion))
+	if err != nil {
+		return squareReport{}, fmt.Errorf("constructing square: %w", err)
+	}
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return squareReport{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return squareReport{}, err
+	}
+
+	return squareReport{
+		Height:     block.Height,
+		AppVersion: appVersion,
+		SquareSize: dataSquare.Size(),
+		DataRoot:   dah.Hash(),
+		DataHash:   block.DataHash,
+		Shares:     infos,
+		Violations: violations,
+	}, nil
+}
+
+func printSquareTable(out io.Writer, report squareReport) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "INDEX\tNAMESPACE\tVERSION\tSTART\tSEQUENCE LEN\tRESERVED\tPADDING\tTXS\tBLOB")
+	for _, info := range report.Shares {
+		reserved, padding, blobIndex := "-", "-", "-"
+		if info.ReservedBytes != nil {
+			reserved = strconv.FormatUint(uint64(*info.ReservedBytes), 10)
+		}
+		if info.Padding != square.NoPadding {
+			padding = string(info.Padding)
+		}
+		if info.BlobIndex != nil {
+			blobIndex = strconv.Itoa(*info.BlobIndex)
+		}
+		txIndexes := make([]string, len(info.TxIndexes))
+		for i, txIndex := range info.TxIndexes {
+			txIndexes[i] = strconv.Itoa(txIndex)
+		}
+		txs := strings.Join(txIndexes, ",")
+		if txs == "" {
+			txs = "-"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%d\t%t\t%d\t%s\t%s\t%s\t%s\n",
+			info.Index, info.Namespace, info.ShareVersion, info.SequenceStart, info.SequenceLen, reserved, padding, txs, blobIndex)
+	}
+	w.Flush()
+}
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[41m"
+	ansiBlue   = "\x1b[34m"
+	ansiCyan   = "\x1b[36m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiGrey   = "\x1b[90m"
+)
+
+// printSquareGrid prints the square as a grid with one coloured cell per
+// share. Blobs that violate the non-interactive default rules are highlighted.
+func printSquareGrid(out io.Writer, report squareReport) {
+	violating := make(map[int]bool, len(report.Violations))
+	for _, v := range report.Violations {
+		violating[v.ShareIndex] = true
+	}
+
+	for i, info := range report.Shares {
+		symbol, color := shareCell(info)
+		if violating[i] {
+			color = ansiRed
+		}
+		fmt.Fprintf(out, "%s%s%s", color, symbol, ansiReset)
+		if (i+1)%report.SquareSize == 0 {
+			fmt.Fprintln(out)
+		} else {
+			fmt.Fprint(out, " ")
+		}
+	}
+	fmt.Fprintf(out, "\n%sT%s tx  %sP%s pfb  %sB%s blob start  %sb%s blob  %sr%s reserved padding  %sn%s namespace padding  %st%s tail padding  %sB%s violation\n",
+		ansiBlue, ansiReset, ansiCyan, ansiReset, ansiGreen, ansiReset, ansiGreen, ansiReset,
+		ansiYellow, ansiReset, ansiYellow, ansiReset, ansiGrey, ansiReset, ansiRed, ansiReset)
+}
+
+func shareCell(info square.ShareInfo) (string, string) {
+	switch {
+	case info.Padding == square.ReservedPadding:
+		return "r", ansiYellow
+	case info.Padding == square.NamespacePadding:
+		return "n", ansiYellow
+	case info.Padding == square.TailPadding:
+		return "t", ansiGrey
+	case info.BlobIndex != nil && info.SequenceStart:
+		return "B", ansiGreen
+	case info.BlobIndex != nil:
+		return "b", ansiGreen
+	case info.Namespace == hex.EncodeToString(appns.TxNamespace.Bytes()):
+		return "T", ansiBlue
+	default:
+		return "P", ansiCyan
+	}
+}
+
+func printSquareSummary(out io.Writer, report squareReport) {
+	fmt.Fprintf(out, "\nheight %d, app version %d, square size %d, %d shares\n", report.Height, report.AppVersion, report.SquareSize, len(report.Shares))
+	switch {
+	case len(report.DataHash) == 0:
+		fmt.Fprintf(out, "data root %s (the block has no data hash)\n", report.DataRoot)
+	case bytes.Equal(report.DataRoot, report.DataHash):
+		fmt.Fprintf(out, "data root %s matches the data hash of the block\n", report.DataRoot)
+	default:
+		fmt.Fprintf(out, "data root %s DOES NOT match the data hash of the block %s\n", report.DataRoot, report.DataHash)
+	}
+	if len(report.Violations) == 0 {
+		fmt.Fprintln(out, "no blob violates the non-interactive default rules")
+		return
+	}
+	fmt.Fprintf(out, "%d blobs violate the non-interactive default rules:\n", len(report.Violations))
+	for _, v := range report.Violations {
+		fmt.Fprintf(out, "  blob of %d shares in namespace %s at share %d (expected %d): %s\n", v.ShareLen, v.Namespace, v.ShareIndex, v.ExpectedIndex, v.Reason)
+	}
+}
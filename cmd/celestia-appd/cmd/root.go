@@ -1,14 +1,17 @@
 package cmd
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 
 	bscmd "github.com/celestiaorg/celestia-app/x/blobstream/client"
+	upgradecli "github.com/celestiaorg/celestia-app/x/upgrade/client/cli"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/simd/cmd"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -123,6 +126,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 	cfg.Seal()
 
 	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(debugSquareCommand())
 
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(app.ModuleBasics, app.DefaultNodeHome),
@@ -137,6 +141,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 		commands.CompactGoLevelDBCmd,
 		addrbookCommand(),
 		downloadGenesisCommand(),
+		toolsCommand(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, NewAppServer, createAppAndExport, addModuleInitFlags)
@@ -153,6 +158,13 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig encoding.Config) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Int(app.FlagErasureWorkers, 0, "Number of goroutines used to erasure code the data square of proposals, defaults to GOMAXPROCS")
+	startCmd.Flags().String(app.FlagPackingStrategy, square.OrderPreservingStrategyName, fmt.Sprintf(
+		"Strategy used to pick the transactions of proposals when they don't all fit in the square: %s (mempool order), %s (greedy by fee per share) or %s (maximize the total fee)",
+		square.OrderPreservingStrategyName, square.FeePerShareStrategyName, square.KnapsackStrategyName,
+	))
+	startCmd.Flags().Bool(app.FlagMinimizePadding, false, "Reorder the blob transactions of proposals to reduce the padding between blobs of the same namespace")
+	startCmd.Flags().Bool(app.FlagLogProposalStats, false, "Log the layout of the square of each prepared proposal as JSON at the debug level")
 }
 
 func queryCommand() *cobra.Command {
@@ -174,6 +186,8 @@ func queryCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddQueryCommands(cmd)
+	// the upgrade module isn't part of the module basics
+	cmd.AddCommand(upgradecli.GetQueryCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
@@ -200,6 +214,8 @@ func txCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddTxCommands(cmd)
+	// the upgrade module isn't part of the module basics
+	cmd.AddCommand(upgradecli.GetTxCmd())
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return cmd
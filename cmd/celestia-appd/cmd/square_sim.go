@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSimInput         = "input"
+	flagSimMaxSquareSize = "max-square-size"
+	flagSimAppVersion    = "app-version"
+)
+
+// pfbSpecJSON is the JSON representation of a PFB in the input file of the
+// square-sim command.
+type pfbSpecJSON struct {
+	TxSize int            `json:"tx_size,omitempty"`
+	Blobs  []blobSpecJSON `json:"blobs"`
+}
+
+type blobSpecJSON struct {
+	// Namespace is the hex encoded user-specifiable portion of a version zero
+	// namespace ID.
+	Namespace string `json:"namespace"`
+	Size      int    `json:"size"`
+}
+
+func toolsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tools",
+		Short: "Offline tools for planning the use of the network",
+	}
+	cmd.AddCommand(squareSimCommand())
+	return cmd
+}
+
+func squareSimCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square-sim [pfb]...",
+		Short: "Simulate the layout of a data square from blob sizes and namespaces",
+		Long: "Simulate the layout of a data square from blob sizes and namespaces.\n" +
+			"Each argument describes a PayForBlobs transaction as a comma separated list of blobs in the format " +
+			"`<namespace>:<size>`, where the namespace is the hex encoded user-specifiable portion of a version zero " +
+			"namespace ID and the size is in bytes. PFBs can also be read from a JSON file containing a list of " +
+			"`{\"tx_size\": <bytes>, \"blobs\": [{\"namespace\": <hex>, \"size\": <bytes>}]}` objects, where the tx size is " +
+			"optional and estimated if omitted. No signed transaction is needed.\n" +
+			"The PFBs are appended to the square in order, the way a block proposer does, and the command reports the " +
+			"resulting square size, the share usage including padding, whether all PFBs fit in the max square size and " +
+			"which ones would be dropped.\n",
+		Example: "celestia-appd tools square-sim 0x0102030405060708090a:100000,0x0102030405060708090a:2000 0x0b0c:500000\n" +
+			"celestia-appd tools square-sim --input pfbs.json --max-square-size 128 --format json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString(flagSquareFormat)
+			if err != nil {
+				return err
+			}
+			if format != squareFormatText && format != squareFormatJSON {
+				return fmt.Errorf("unsupported format %q, must be one of %s or %s", format, squareFormatText, squareFormatJSON)
+			}
+			maxSquareSize, err := cmd.Flags().GetInt(flagSimMaxSquareSize)
+			if err != nil {
+				return err
+			}
+			appVersion, err := cmd.Flags().GetUint64(flagSimAppVersion)
+			if err != nil {
+				return err
+			}
+			if upperBound := appconsts.SquareSizeUpperBound(appVersion); maxSquareSize > upperBound {
+				return fmt.Errorf("max square size %d exceeds the upper bound %d of app version %d", maxSquareSize, upperBound, appVersion)
+			}
+			input, err := cmd.Flags().GetString(flagSimInput)
+			if err != nil {
+				return err
+			}
+
+			pfbs, err := parsePFBSpecs(input, args)
+			if err != nil {
+				return err
+			}
+			result, err := square.Simulate(pfbs, appVersion, maxSquareSize)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if format == squareFormatJSON {
+				bz, err := json.MarshalIndent(result, "", "  ")
+				if err != nil {
+					return err
+				}
+				_, err = fmt.Fprintln(out, string(bz))
+				return err
+			}
+			printSimulation(out, result)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSimInput, "", "Path to a JSON file containing the PFBs to simulate, in addition to the ones passed as arguments")
+	cmd.Flags().Int(flagSimMaxSquareSize, appconsts.DefaultGovMaxSquareSize, "Max square size, i.e. the GovMaxSquareSize parameter of the blob module")
+	cmd.Flags().Uint64(flagSimAppVersion, appconsts.LatestVersion, "App version whose share commitment rules are used")
+	cmd.Flags().String(flagSquareFormat, squareFormatText, fmt.Sprintf("Output format: %s or %s", squareFormatText, squareFormatJSON))
+	return cmd
+}
+
+// parsePFBSpecs parses the PFBs of the input file, if any, followed by the
+// PFBs passed as arguments.
+func parsePFBSpecs(input string, args []string) ([]square.PFBSpec, error) {
+	specs := make([]pfbSpecJSON, 0, len(args))
+	if input != "" {
+		bz, err := os.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(bytes.NewReader(bz))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&specs); err != nil {
+			return nil, fmt.Errorf("decoding PFBs from %s: %w", input, err)
+		}
+	}
+	for i, arg := range args {
+		spec := pfbSpecJSON{}
+		for _, blobArg := range strings.Split(arg, ",") {
+			hexNamespace, size, ok := strings.Cut(blobArg, ":")
+			if !ok {
+				return nil, fmt.Errorf("pfb argument %d: blob %q must be in the format <namespace>:<size>", i, blobArg)
+			}
+			blobSize, err := strconv.Atoi(size)
+			if err != nil {
+				return nil, fmt.Errorf("pfb argument %d: invalid blob size %q: %w", i, size, err)
+			}
+			spec.Blobs = append(spec.Blobs, blobSpecJSON{Namespace: hexNamespace, Size: blobSize})
+		}
+		specs = append(specs, spec)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("no PFB to simulate, pass them as arguments or with --%s", flagSimInput)
+	}
+
+	pfbs := make([]square.PFBSpec, len(specs))
+	for i, spec := range specs {
+		pfbs[i].TxSize = spec.TxSize
+		for _, b := range spec.Blobs {
+			subID, err := hex.DecodeString(strings.TrimPrefix(b.Namespace, "0x"))
+			if err != nil {
+				return nil, fmt.Errorf("pfb %d: failed to decode hex namespace ID: %w", i, err)
+			}
+			ns, err := appns.NewV0(subID)
+			if err != nil {
+				return nil, fmt.Errorf("pfb %d: %w", i, err)
+			}
+			pfbs[i].Blobs = append(pfbs[i].Blobs, square.BlobSpec{Namespace: ns, Size: b.Size})
+		}
+	}
+	return pfbs, nil
+}
+
+func printSimulation(out io.Writer, result square.SimulationResult) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PFB\tBLOB\tNAMESPACE\tSIZE\tSHARE INDEX\tSHARES\tPADDING")
+	for _, b := range result.Blobs {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%d\t%d\t%d\n", b.PFBIndex, b.BlobIndex, b.Namespace, b.Size, b.ShareIndex, b.ShareLen, b.Padding)
+	}
+	w.Flush()
+
+	fmt.Fprintf(out, "\nsquare size %d (max %d, min required %d), %d shares\n", result.SquareSize, result.MaxSquareSize, result.MinSquareSize, result.TotalShares)
+	fmt.Fprintf(out, "%d tx shares, %d pfb shares, %d blob shares\n", result.TxShares, result.PFBShares, result.BlobShares)
+	fmt.Fprintf(out, "%d padding shares (%.1f%%): %d reserved, %d namespace, %d tail\n",
+		result.PaddingShares(), 100*float64(result.PaddingShares())/float64(result.TotalShares),
+		result.ReservedPaddingShares, result.NamespacePaddingShares, result.TailPaddingShares)
+	if result.Fits {
+		fmt.Fprintf(out, "all %d PFBs fit in the max square size\n", len(result.IncludedPFBs))
+		return
+	}
+	dropped := make([]string, len(result.DroppedPFBs))
+	for i, index := range result.DroppedPFBs {
+		dropped[i] = strconv.Itoa(index)
+	}
+	fmt.Fprintf(out, "%d of %d PFBs don't fit in the max square size and would be dropped: %s\n",
+		len(result.DroppedPFBs), len(result.IncludedPFBs)+len(result.DroppedPFBs), strings.Join(dropped, ","))
+}
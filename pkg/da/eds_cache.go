@@ -0,0 +1,129 @@
+package da
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+)
+
+// DefaultEDSCacheSize is the default number of heights for which an EDSCache
+// keeps the extended data square. It covers the last committed height and the
+// height being processed.
+const DefaultEDSCacheSize = 2
+
+// EDSCache is a concurrency safe cache of extended data squares and their data
+// availability headers keyed by height. It keeps at most one extended data
+// square per height and evicts the lowest heights once it holds more than its
+// capacity.
+type EDSCache struct {
+	mtx      sync.RWMutex
+	capacity int
+	entries  map[int64]edsCacheEntry
+}
+
+type edsCacheEntry struct {
+	eds *rsmt2d.ExtendedDataSquare
+	dah DataAvailabilityHeader
+}
+
+// NewEDSCache returns an EDSCache that keeps the extended data squares of at
+// most capacity heights. If capacity is not positive, DefaultEDSCacheSize is
+// used.
+func NewEDSCache(capacity int) *EDSCache {
+	if capacity <= 0 {
+		capacity = DefaultEDSCacheSize
+	}
+	return &EDSCache{
+		capacity: capacity,
+		entries:  make(map[int64]edsCacheEntry, capacity+1),
+	}
+}
+
+// Add caches the extended data square of the block at height and its data
+// availability header, replacing any extended data square previously cached
+// at that height. The extended data square must not be modified afterwards.
+func (c *EDSCache) Add(height int64, eds *rsmt2d.ExtendedDataSquare, dah DataAvailabilityHeader) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.entries[height] = edsCacheEntry{eds: eds, dah: dah}
+	if len(c.entries) <= c.capacity {
+		return
+	}
+	heights := c.heights()
+	for _, h := range heights[:len(heights)-c.capacity] {
+		delete(c.entries, h)
+	}
+}
+
+// Get returns the extended data square cached at height if its data root is
+// dataRoot. The returned extended data square shares its shares with the
+// cached one so they must not be modified.
+func (c *EDSCache) Get(height int64, dataRoot []byte) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, bool) {
+	if c == nil {
+		return nil, DataAvailabilityHeader{}, false
+	}
+	c.mtx.RLock()
+	entry, ok := c.entries[height]
+	c.mtx.RUnlock()
+	if !ok || !bytes.Equal(entry.dah.Hash(), dataRoot) {
+		return nil, DataAvailabilityHeader{}, false
+	}
+	return entry.get()
+}
+
+// Prune evicts the extended data squares cached below height.
+func (c *EDSCache) Prune(height int64) {
+	if c == nil {
+		return
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for h := range c.entries {
+		if h < height {
+			delete(c.entries, h)
+		}
+	}
+}
+
+// Len returns the number of cached extended data squares.
+func (c *EDSCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return len(c.entries)
+}
+
+// get returns a copy of the cached extended data square. The cached extended
+// data square is imported again so that callers don't share the roots it
+// computes lazily.
+func (e edsCacheEntry) get() (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, bool) {
+	eds, err := rsmt2d.ImportExtendedDataSquare(
+		e.eds.Flattened(),
+		appconsts.DefaultCodec(),
+		wrapper.NewConstructor(uint64(e.eds.Width()/2)),
+	)
+	if err != nil {
+		return nil, DataAvailabilityHeader{}, false
+	}
+	return eds, e.dah, true
+}
+
+// heights returns the cached heights in ascending order.
+func (c *EDSCache) heights() []int64 {
+	heights := make([]int64, 0, len(c.entries))
+	for h := range c.entries {
+		heights = append(heights, h)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights
+}
@@ -0,0 +1,66 @@
+package da
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestEDSCache(t *testing.T) {
+	cache := NewEDSCache(2)
+	eds, dah := generateEDS(t, 4)
+	cache.Add(1, eds, dah)
+
+	got, gotDAH, ok := cache.Get(1, dah.Hash())
+	require.True(t, ok)
+	assert.True(t, eds.Equals(got))
+	assert.Equal(t, dah.Hash(), gotDAH.Hash())
+
+	_, _, ok = cache.Get(1, tmrand.Bytes(32))
+	assert.False(t, ok)
+	_, _, ok = cache.Get(2, dah.Hash())
+	assert.False(t, ok)
+}
+
+func TestEDSCacheEviction(t *testing.T) {
+	cache := NewEDSCache(2)
+	dahs := make([]DataAvailabilityHeader, 4)
+	for height := 1; height < len(dahs); height++ {
+		var eds *rsmt2d.ExtendedDataSquare
+		eds, dahs[height] = generateEDS(t, 2)
+		cache.Add(int64(height), eds, dahs[height])
+	}
+	assert.Equal(t, 2, cache.Len())
+	_, _, ok := cache.Get(1, dahs[1].Hash())
+	assert.False(t, ok)
+	_, _, ok = cache.Get(3, dahs[3].Hash())
+	assert.True(t, ok)
+
+	cache.Prune(3)
+	assert.Equal(t, 1, cache.Len())
+	_, _, ok = cache.Get(2, dahs[2].Hash())
+	assert.False(t, ok)
+	_, _, ok = cache.Get(3, dahs[3].Hash())
+	assert.True(t, ok)
+}
+
+func TestNilEDSCache(t *testing.T) {
+	var cache *EDSCache
+	eds, dah := generateEDS(t, 2)
+	cache.Add(1, eds, dah)
+	_, _, ok := cache.Get(1, dah.Hash())
+	assert.False(t, ok)
+	cache.Prune(1)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func generateEDS(t *testing.T, squareSize int) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader) {
+	eds, err := ExtendShares(generateRandomShares(squareSize * squareSize))
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah
+}
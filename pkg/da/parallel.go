@@ -0,0 +1,182 @@
+package da
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+)
+
+// ExtendSharesParallel erasure codes the shares into an extended data square
+// like ExtendShares, but encodes the rows and columns of the square with at
+// most workers goroutines. If workers is not positive, GOMAXPROCS goroutines
+// are used. The returned extended data square is identical to the one returned
+// by ExtendShares.
+func ExtendSharesParallel(s [][]byte, workers int) (*rsmt2d.ExtendedDataSquare, error) {
+	// Check that the length of the square is a power of 2.
+	if !shares.IsPowerOfTwo(len(s)) {
+		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
+	}
+	codec := appconsts.DefaultCodec()
+	if len(s) > codec.MaxChunks() {
+		return nil, errors.New("number of chunks exceeds the maximum")
+	}
+	chunkSize := len(s[0])
+	if err := codec.ValidateChunkSize(chunkSize); err != nil {
+		return nil, err
+	}
+	for _, share := range s {
+		if len(share) != chunkSize {
+			return nil, rsmt2d.ErrUnevenChunks
+		}
+	}
+
+	squareSize := SquareSize(len(s))
+	width := 2 * squareSize
+	eds := make([][]byte, width*width)
+	for i := 0; i < squareSize; i++ {
+		copy(eds[i*width:i*width+squareSize], s[i*squareSize:(i+1)*squareSize])
+	}
+
+	// Encode the rows of the original square into the upper right quadrant and
+	// its columns into the lower left quadrant.
+	err := parallelize(2*squareSize, workers, func(i int) error {
+		if i < squareSize {
+			return extendAxis(codec, eds, width, squareSize, rsmt2d.Row, i)
+		}
+		return extendAxis(codec, eds, width, squareSize, rsmt2d.Col, i-squareSize)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Encode the rows of the lower left quadrant into the lower right
+	// quadrant.
+	err = parallelize(squareSize, workers, func(i int) error {
+		return extendAxis(codec, eds, width, squareSize, rsmt2d.Row, squareSize+i)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsmt2d.ImportExtendedDataSquare(eds, codec, wrapper.NewConstructor(uint64(squareSize)))
+}
+
+// NewDataAvailabilityHeaderParallel generates a DataAvailability header using
+// the provided extended data square like NewDataAvailabilityHeader, but
+// computes the row and column roots with at most workers goroutines. If
+// workers is not positive, GOMAXPROCS goroutines are used.
+func NewDataAvailabilityHeaderParallel(eds *rsmt2d.ExtendedDataSquare, workers int) (DataAvailabilityHeader, error) {
+	width := int(eds.Width())
+	squareSize := uint64(width / 2)
+	// Flattened doesn't copy the shares
+	flattened := eds.Flattened()
+
+	rowRoots := make([][]byte, width)
+	colRoots := make([][]byte, width)
+	err := parallelize(2*width, workers, func(i int) error {
+		axis, axisIndex := rsmt2d.Row, i
+		if i >= width {
+			axis, axisIndex = rsmt2d.Col, i-width
+		}
+		tree := wrapper.NewConstructor(squareSize)(axis, uint(axisIndex))
+		for j := 0; j < width; j++ {
+			share := flattened[axisIndex*width+j]
+			if axis == rsmt2d.Col {
+				share = flattened[j*width+axisIndex]
+			}
+			if share == nil {
+				return errors.New("can not compute root of incomplete axis")
+			}
+			if err := tree.Push(share); err != nil {
+				return err
+			}
+		}
+		root, err := tree.Root()
+		if err != nil {
+			return err
+		}
+		if axis == rsmt2d.Row {
+			rowRoots[axisIndex] = root
+		} else {
+			colRoots[axisIndex] = root
+		}
+		return nil
+	})
+	if err != nil {
+		return DataAvailabilityHeader{}, err
+	}
+
+	dah := DataAvailabilityHeader{
+		RowRoots:    rowRoots,
+		ColumnRoots: colRoots,
+	}
+	// Generate the hash of the data using the new roots
+	dah.Hash()
+	return dah, nil
+}
+
+// extendAxis encodes the first squareSize shares of the row or column at
+// index of the flattened extended data square eds into its last squareSize
+// shares.
+func extendAxis(codec rsmt2d.Codec, eds [][]byte, width, squareSize int, axis rsmt2d.Axis, index int) error {
+	cell := func(j int) int {
+		if axis == rsmt2d.Row {
+			return index*width + j
+		}
+		return j*width + index
+	}
+
+	data := make([][]byte, squareSize)
+	for j := range data {
+		data[j] = eds[cell(j)]
+	}
+	parity, err := codec.Encode(data)
+	if err != nil {
+		return err
+	}
+	for j, share := range parity {
+		eds[cell(squareSize+j)] = share
+	}
+	return nil
+}
+
+// parallelize calls fn for each index in [0, n) with at most workers
+// goroutines and returns the first error encountered.
+func parallelize(n, workers int, fn func(i int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	indexes := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := fn(i); err != nil {
+					once.Do(func() { firstErr = err })
+				}
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,58 @@
+package da
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestExtendSharesParallel(t *testing.T) {
+	for _, squareSize := range []int{1, 2, 8, 32, 64} {
+		shares := generateRandomShares(squareSize * squareSize)
+		eds, err := ExtendShares(shares)
+		require.NoError(t, err)
+		dah, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+
+		for _, workers := range []int{0, 1, 3, 2 * squareSize} {
+			t.Run(fmt.Sprintf("squareSize=%d workers=%d", squareSize, workers), func(t *testing.T) {
+				got, err := ExtendSharesParallel(shares, workers)
+				require.NoError(t, err)
+				assert.True(t, eds.Equals(got))
+
+				gotDAH, err := NewDataAvailabilityHeaderParallel(got, workers)
+				require.NoError(t, err)
+				assert.Equal(t, dah.RowRoots, gotDAH.RowRoots)
+				assert.Equal(t, dah.ColumnRoots, gotDAH.ColumnRoots)
+				assert.Equal(t, dah.Hash(), gotDAH.Hash())
+			})
+		}
+	}
+}
+
+func TestExtendSharesParallelErrors(t *testing.T) {
+	_, err := ExtendSharesParallel(generateRandomShares(3), 0)
+	assert.Error(t, err)
+
+	shares := generateRandomShares(4)
+	shares[2] = shares[2][:appconsts.ShareSize-1]
+	_, err = ExtendSharesParallel(shares, 0)
+	assert.Error(t, err)
+}
+
+// generateRandomShares generates count shares with random data and sorted
+// random blob namespaces.
+func generateRandomShares(count int) [][]byte {
+	shares := make([][]byte, count)
+	for i := range shares {
+		shares[i] = tmrand.Bytes(appconsts.ShareSize)
+		copy(shares[i], appns.RandomBlobNamespace().Bytes())
+	}
+	sortByteArrays(shares)
+	return shares
+}
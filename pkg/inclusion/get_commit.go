@@ -10,13 +10,37 @@ import (
 // GetCommitment gets the share commitment for a blob in the original data
 // square.
 func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]byte, error) {
+	rowSubTreeRoots, err := GetSubTreeRoots(cacher, dah, start, blobShareLen, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	subTreeRoots := make([][]byte, 0)
+	for _, row := range rowSubTreeRoots {
+		subTreeRoots = append(subTreeRoots, row.Roots...)
+	}
+	return merkle.HashFromByteSlices(subTreeRoots), nil
+}
+
+// RowSubTreeRoots are the subtree roots used to create the share commitment of
+// a blob that are part of a single row of the data square.
+type RowSubTreeRoots struct {
+	// Row is the index of the row in the data square.
+	Row int
+	// Roots are the subtree roots ordered from left to right.
+	Roots [][]byte
+}
+
+// GetSubTreeRoots gets the subtree roots used to create the share commitment
+// for a blob in the original data square, grouped by row. The share
+// commitment is the merkle root of all the returned subtree roots in order.
+func GetSubTreeRoots(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader, start, blobShareLen, subtreeRootThreshold int) ([]RowSubTreeRoots, error) {
 	squareSize := len(dah.RowRoots) / 2
 	if start+blobShareLen > squareSize*squareSize {
 		return nil, errors.New("cannot get commitment for blob that doesn't fit in square")
 	}
 	paths := calculateCommitmentPaths(squareSize, start, blobShareLen, subtreeRootThreshold)
-	subTreeRoots := make([][]byte, len(paths))
-	for i, path := range paths {
+	rows := make([]RowSubTreeRoots, 0)
+	for _, path := range paths {
 		// here we prepend false (walk left down the tree) because we only need
 		// the subtree roots from the original data square.
 		orignalSquarePath := append(append(make([]WalkInstruction, 0, len(path.instructions)+1), WalkLeft), path.instructions...)
@@ -24,7 +48,12 @@ func GetCommitment(cacher *EDSSubTreeRootCacher, dah da.DataAvailabilityHeader,
 		if err != nil {
 			return nil, err
 		}
-		subTreeRoots[i] = subTreeRoot
+		// the paths are ordered by row so a new row only needs to be added
+		// when the row of the path changes.
+		if len(rows) == 0 || rows[len(rows)-1].Row != path.row {
+			rows = append(rows, RowSubTreeRoots{Row: path.row})
+		}
+		rows[len(rows)-1].Roots = append(rows[len(rows)-1].Roots, subTreeRoot)
 	}
-	return merkle.HashFromByteSlices(subTreeRoots), nil
+	return rows, nil
 }
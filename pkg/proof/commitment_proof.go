@@ -0,0 +1,258 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// NewCommitmentProof returns a proof of the share commitment of the blob that
+// occupies shareRange in the data square. The share range is expected to be
+// the one of a single blob, as returned by square.BlobShareRange.
+func NewCommitmentProof(dataSquare square.Square, shareRange shares.Range, subtreeRootThreshold int) (CommitmentProof, error) {
+	if shareRange.IsEmpty() {
+		return CommitmentProof{}, errors.New("share range cannot be empty")
+	}
+	namespace, err := ParseNamespace(dataSquare, shareRange.Start, shareRange.End)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	// extend the square using the subtree root cacher so that the subtree
+	// roots of the blob can be read from the row trees.
+	squareSize := dataSquare.Size()
+	cacher := inclusion.NewSubtreeCacher(uint64(squareSize))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	rowSubTreeRoots, err := inclusion.GetSubTreeRoots(cacher, dah, shareRange.Start, shareRange.End-shareRange.Start, subtreeRootThreshold)
+	if err != nil {
+		return CommitmentProof{}, err
+	}
+
+	startRow := shareRange.Start / squareSize
+	endRow := (shareRange.End - 1) / squareSize
+	if len(rowSubTreeRoots) != endRow-startRow+1 {
+		return CommitmentProof{}, fmt.Errorf("blob spans %d rows but has subtree roots in %d rows", endRow-startRow+1, len(rowSubTreeRoots))
+	}
+
+	// create the binary merkle inclusion proof for all the square rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(dah.RowRoots, dah.ColumnRoots...))
+	rowProof := &RowProof{
+		RowRoots: make([][]byte, 0, endRow-startRow+1),
+		Proofs:   make([]*crypto.Proof, 0, endRow-startRow+1),
+		StartRow: uint32(startRow),
+		EndRow:   uint32(endRow),
+	}
+	rows := make([]*RowSubtreeRootsProof, 0, endRow-startRow+1)
+	subTreeRoots := make([][]byte, 0)
+	for _, row := range rowSubTreeRoots {
+		// create an nmt to generate a proof.
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(row.Row))
+		for _, share := range eds.Row(uint(row.Row)) {
+			if err := tree.Push(share); err != nil {
+				return CommitmentProof{}, err
+			}
+		}
+
+		// make sure that the generated root is the same as the eds row root.
+		root, err := tree.Root()
+		if err != nil {
+			return CommitmentProof{}, err
+		}
+		if !bytes.Equal(dah.RowRoots[row.Row], root) {
+			return CommitmentProof{}, errors.New("eds row root is different than tree root")
+		}
+
+		start, end := rowLeafRange(squareSize, shareRange, row.Row)
+		proof, err := tree.ProveRange(start, end)
+		if err != nil {
+			return CommitmentProof{}, err
+		}
+
+		subTreeRoots = append(subTreeRoots, row.Roots...)
+		rowProof.RowRoots = append(rowProof.RowRoots, dah.RowRoots[row.Row])
+		rowProof.Proofs = append(rowProof.Proofs, allProofs[row.Row].ToProto())
+		rows = append(rows, &RowSubtreeRootsProof{
+			SubtreeRoots: row.Roots,
+			Proof: &NMTProof{
+				Start:    int32(proof.Start()),
+				End:      int32(proof.End()),
+				Nodes:    proof.Nodes(),
+				LeafHash: proof.LeafHash(),
+			},
+		})
+	}
+
+	return CommitmentProof{
+		NamespaceId:          namespace.ID,
+		NamespaceVersion:     uint32(namespace.Version),
+		ShareCommitment:      merkle.HashFromByteSlices(subTreeRoots),
+		ShareStart:           uint32(shareRange.Start),
+		ShareEnd:             uint32(shareRange.End),
+		SubtreeRootThreshold: uint32(subtreeRootThreshold),
+		RowProof:             rowProof,
+		Rows:                 rows,
+	}, nil
+}
+
+// Validate runs basic validations on the proof then verifies that commitment
+// is the share commitment of a blob in the data square committed to by root.
+// The subtree root threshold is the one of appVersion, the app version of the
+// block, rather than the one claimed by the proof. It returns nil if the proof
+// is valid.
+func (p CommitmentProof) Validate(root []byte, commitment []byte, appVersion uint64) error {
+	namespace, err := appns.New(uint8(p.NamespaceVersion), p.NamespaceId)
+	if err != nil {
+		return err
+	}
+	if p.ShareEnd <= p.ShareStart {
+		return fmt.Errorf("share end %d must be greater than share start %d", p.ShareEnd, p.ShareStart)
+	}
+	if !bytes.Equal(p.ShareCommitment, commitment) {
+		return fmt.Errorf("share commitment of the proof %X doesn't match the expected one %X", p.ShareCommitment, commitment)
+	}
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	if int(p.SubtreeRootThreshold) != subtreeRootThreshold {
+		return fmt.Errorf("subtree root threshold %d of the proof doesn't match the threshold %d of app version %d", p.SubtreeRootThreshold, subtreeRootThreshold, appVersion)
+	}
+	squareSize, err := verifyRowProof(p.RowProof, root)
+	if err != nil {
+		return err
+	}
+	if len(p.Rows) != len(p.RowProof.RowRoots) {
+		return fmt.Errorf("the number of row subtree roots proofs %d must equal the number of row roots %d", len(p.Rows), len(p.RowProof.RowRoots))
+	}
+
+	// verify that the proven rows are exactly the ones containing the blob and
+	// that the blob follows the blob share commitment rules, so that the
+	// subtree roots are the ones expected for the share commitment.
+	size := int(squareSize)
+	shareRange := shares.NewRange(int(p.ShareStart), int(p.ShareEnd))
+	blobShareLen := shareRange.End - shareRange.Start
+	if shareRange.End > size*size {
+		return fmt.Errorf("share end %d is outside of the original data square of size %d", shareRange.End, size)
+	}
+	if int(p.RowProof.StartRow) != shareRange.Start/size || int(p.RowProof.EndRow) != (shareRange.End-1)/size {
+		return fmt.Errorf("rows [%d, %d] are not the rows containing the shares [%d, %d)", p.RowProof.StartRow, p.RowProof.EndRow, shareRange.Start, shareRange.End)
+	}
+	if inclusion.NextShareIndex(shareRange.Start, blobShareLen, subtreeRootThreshold) != shareRange.Start {
+		return fmt.Errorf("share start %d doesn't follow the blob share commitment rules", shareRange.Start)
+	}
+	subTreeWidth := inclusion.SubTreeWidth(blobShareLen, subtreeRootThreshold)
+
+	// verify that the subtree roots of each row are part of the row root
+	subTreeRoots := make([][]byte, 0)
+	for i, row := range p.Rows {
+		rowIndex := int(p.RowProof.StartRow) + i
+		if row == nil || row.Proof == nil {
+			return fmt.Errorf("row subtree roots proof %d cannot be nil", i)
+		}
+		start, end := rowLeafRange(size, shareRange, rowIndex)
+		if int(row.Proof.Start) != start || int(row.Proof.End) != end {
+			return fmt.Errorf("proof for row %d covers [%d, %d) but should cover [%d, %d)", rowIndex, row.Proof.Start, row.Proof.End, start, end)
+		}
+		for _, subTreeRoot := range row.SubtreeRoots {
+			if len(subTreeRoot) < 2*appconsts.NamespaceSize ||
+				!bytes.Equal(minNamespace(subTreeRoot), namespace.Bytes()) ||
+				!bytes.Equal(maxNamespace(subTreeRoot), namespace.Bytes()) {
+				return fmt.Errorf("subtree root %X of row %d is not of namespace %X", subTreeRoot, rowIndex, namespace.Bytes())
+			}
+		}
+		rowRoot, err := computeRowRoot(size, start, end, subTreeWidth, row.SubtreeRoots, row.Proof.Nodes)
+		if err != nil {
+			return fmt.Errorf("row %d: %w", rowIndex, err)
+		}
+		if !bytes.Equal(rowRoot, p.RowProof.RowRoots[i]) {
+			return fmt.Errorf("subtree roots proof failed to verify for row %d", rowIndex)
+		}
+		subTreeRoots = append(subTreeRoots, row.SubtreeRoots...)
+	}
+
+	if !bytes.Equal(merkle.HashFromByteSlices(subTreeRoots), commitment) {
+		return errors.New("the subtree roots don't match the share commitment")
+	}
+	return nil
+}
+
+// rowLeafRange returns the range [start, end) of the leaves of the row
+// that are part of shareRange.
+func rowLeafRange(squareSize int, shareRange shares.Range, row int) (start, end int) {
+	start, end = 0, squareSize
+	if row == shareRange.Start/squareSize {
+		start = shareRange.Start % squareSize
+	}
+	if row == (shareRange.End-1)/squareSize {
+		end = shareRange.End - row*squareSize
+	}
+	return start, end
+}
+
+// computeRowRoot computes the root of an extended row from the subtree roots
+// of the leaves [start, end) and the nodes of an NMT range proof of those
+// leaves. Each subtree root is the root of the largest subtree of at most
+// subTreeWidth leaves within [start, end), which is the same decomposition of
+// the range as the one used to create the share commitment.
+func computeRowRoot(squareSize, start, end, subTreeWidth int, subTreeRoots, nodes [][]byte) ([]byte, error) {
+	hasher := nmt.NewNmtHasher(appconsts.NewBaseHashFunc(), appconsts.NamespaceSize, true)
+	var compute func(s, e int) ([]byte, error)
+	compute = func(s, e int) ([]byte, error) {
+		switch {
+		// the subtree is outside of the range so its root is a proof node
+		case e <= start || s >= end:
+			if len(nodes) == 0 {
+				return nil, errors.New("not enough proof nodes")
+			}
+			node := nodes[0]
+			nodes = nodes[1:]
+			return node, nil
+		// the subtree is within the range so its root is a subtree root
+		case s >= start && e <= end && e-s <= subTreeWidth:
+			if len(subTreeRoots) == 0 {
+				return nil, errors.New("not enough subtree roots")
+			}
+			root := subTreeRoots[0]
+			subTreeRoots = subTreeRoots[1:]
+			return root, nil
+		default:
+			mid := s + (e-s)/2
+			left, err := compute(s, mid)
+			if err != nil {
+				return nil, err
+			}
+			right, err := compute(mid, e)
+			if err != nil {
+				return nil, err
+			}
+			return hasher.HashNode(left, right)
+		}
+	}
+
+	root, err := compute(0, 2*squareSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 0 || len(subTreeRoots) != 0 {
+		return nil, fmt.Errorf("%d proof nodes and %d subtree roots were not used", len(nodes), len(subTreeRoots))
+	}
+	return root, nil
+}
@@ -0,0 +1,105 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommitmentProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobSizes := []int{100, 5000, 20000, 100000}
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns2, ns1, ns2}, blobSizes)
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(rawTxs, appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	for i := range blobSizes {
+		txIndex := 50 + i
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(rawTxs[txIndex])
+		require.True(t, isBlobTx)
+		commitment, err := inclusion.CreateCommitment(blobTx.Blobs[0])
+		require.NoError(t, err)
+
+		shareRange, err := square.BlobShareRange(rawTxs, txIndex, 0, appconsts.LatestVersion)
+		require.NoError(t, err)
+
+		commitmentProof, err := proof.NewCommitmentProof(dataSquare, shareRange, appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+		require.NoError(t, err)
+		assert.Equal(t, commitment, commitmentProof.ShareCommitment)
+		assert.Equal(t, blobTx.Blobs[0].NamespaceId, commitmentProof.NamespaceId)
+		assert.NoError(t, commitmentProof.Validate(dataRoot, commitment, appconsts.LatestVersion))
+
+		// the proof must not verify against a different data root
+		assert.Error(t, commitmentProof.Validate(dah.RowRoots[0], commitment, appconsts.LatestVersion))
+
+		// the proof must not verify for a different share commitment
+		wrongCommitment := bytes.Repeat([]byte{0xFF}, len(commitment))
+		assert.Error(t, commitmentProof.Validate(dataRoot, wrongCommitment, appconsts.LatestVersion))
+
+		// the proof must not verify if it claims a different share commitment,
+		// even when that commitment is the expected one
+		claimsWrongCommitment := commitmentProof
+		claimsWrongCommitment.ShareCommitment = wrongCommitment
+		assert.Error(t, claimsWrongCommitment.Validate(dataRoot, wrongCommitment, appconsts.LatestVersion))
+
+		// the proof must not verify if it claims a different subtree root
+		// threshold than the one of the app version
+		wrongThreshold := commitmentProof
+		wrongThreshold.SubtreeRootThreshold *= 2
+		assert.Error(t, wrongThreshold.Validate(dataRoot, commitment, appconsts.LatestVersion))
+
+		// the proof must not verify if a subtree root is modified
+		wrongSubtreeRoot := commitmentProof
+		wrongSubtreeRoot.Rows = append([]*proof.RowSubtreeRootsProof{}, commitmentProof.Rows...)
+		subtreeRoots := append([][]byte{}, commitmentProof.Rows[0].SubtreeRoots...)
+		subtreeRoots[0] = append(append([]byte{}, subtreeRoots[0][:len(subtreeRoots[0])-1]...), subtreeRoots[0][len(subtreeRoots[0])-1]^0xFF)
+		wrongSubtreeRoot.Rows[0] = &proof.RowSubtreeRootsProof{
+			SubtreeRoots: subtreeRoots,
+			Proof:        commitmentProof.Rows[0].Proof,
+		}
+		assert.Error(t, wrongSubtreeRoot.Validate(dataRoot, commitment, appconsts.LatestVersion))
+
+		// the proof must not verify if the blob is claimed to be elsewhere
+		wrongRange := commitmentProof
+		wrongRange.ShareStart++
+		assert.Error(t, wrongRange.Validate(dataRoot, commitment, appconsts.LatestVersion))
+	}
+}
+
+func TestNewCommitmentProofInvalidRange(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(20, 500)
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	_, err = proof.NewCommitmentProof(dataSquare, shares.NewRange(0, 0), appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+	_, err = proof.NewCommitmentProof(dataSquare, shares.NewRange(0, len(dataSquare)+1), appconsts.DefaultSubtreeRootThreshold)
+	assert.Error(t, err)
+}
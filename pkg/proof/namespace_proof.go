@@ -0,0 +1,251 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// NewNamespaceProof returns a proof of all the shares of the namespace in the
+// data square. If the square doesn't contain any share of the namespace, the
+// returned proof proves the absence of the namespace instead.
+func NewNamespaceProof(dataSquare square.Square, namespace appns.Namespace) (NamespaceProof, error) {
+	shareRange, err := shares.GetShareRangeForNamespace(dataSquare, namespace)
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	squareSize := dataSquare.Size()
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	edsRowRoots, err := eds.RowRoots()
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	edsColRoots, err := eds.ColRoots()
+	if err != nil {
+		return NamespaceProof{}, err
+	}
+
+	// find the rows of the original data square whose namespace range
+	// includes the namespace. As the shares are ordered by namespace, these
+	// rows are contiguous. If the namespace is absent, startRow is the row
+	// that would contain it and endRow the row before it.
+	startRow, endRow := squareSize, -1
+	for i := 0; i < squareSize; i++ {
+		if startRow == squareSize && bytes.Compare(namespace.Bytes(), maxNamespace(edsRowRoots[i])) <= 0 {
+			startRow = i
+		}
+		if bytes.Compare(minNamespace(edsRowRoots[i]), namespace.Bytes()) <= 0 {
+			endRow = i
+		}
+	}
+	// include the rows directly before and after the namespace so that the
+	// completeness of the proof can be verified against the data root.
+	if startRow > 0 {
+		startRow--
+	}
+	if endRow < squareSize-1 {
+		endRow++
+	}
+
+	// create the binary merkle inclusion proof for all the square rows to the data root
+	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	rowProof := &RowProof{
+		RowRoots: make([][]byte, 0, endRow-startRow+1),
+		Proofs:   make([]*crypto.Proof, 0, endRow-startRow+1),
+		StartRow: uint32(startRow),
+		EndRow:   uint32(endRow),
+	}
+	rows := make([]*RowNamespaceProof, 0, endRow-startRow+1)
+	sharesProven := 0
+	for i := startRow; i <= endRow; i++ {
+		// create an nmt to generate a proof.
+		// we have to re-create the tree as the eds one is not accessible.
+		tree := wrapper.NewErasuredNamespacedMerkleTree(uint64(squareSize), uint(i))
+		row := eds.Row(uint(i))
+		for _, share := range row {
+			if err := tree.Push(share); err != nil {
+				return NamespaceProof{}, err
+			}
+		}
+
+		// make sure that the generated root is the same as the eds row root.
+		root, err := tree.Root()
+		if err != nil {
+			return NamespaceProof{}, err
+		}
+		if !bytes.Equal(edsRowRoots[i], root) {
+			return NamespaceProof{}, errors.New("eds row root is different than tree root")
+		}
+
+		proof, err := tree.ProveNamespace(namespace.Bytes())
+		if err != nil {
+			return NamespaceProof{}, err
+		}
+
+		var rowShares [][]byte
+		if !proof.IsOfAbsence() && proof.IsNonEmptyRange() {
+			rowShares = row[proof.Start():proof.End()]
+		}
+		sharesProven += len(rowShares)
+
+		rowProof.RowRoots = append(rowProof.RowRoots, edsRowRoots[i])
+		rowProof.Proofs = append(rowProof.Proofs, allProofs[i].ToProto())
+		rows = append(rows, &RowNamespaceProof{
+			Shares: rowShares,
+			Proof: &NMTProof{
+				Start:    int32(proof.Start()),
+				End:      int32(proof.End()),
+				Nodes:    proof.Nodes(),
+				LeafHash: proof.LeafHash(),
+			},
+		})
+	}
+
+	// defensively check that the proof covers all the shares of the namespace
+	if sharesProven != shareRange.End-shareRange.Start {
+		return NamespaceProof{}, fmt.Errorf("proof contains %d shares but the namespace has %d", sharesProven, shareRange.End-shareRange.Start)
+	}
+
+	return NamespaceProof{
+		NamespaceId:      namespace.ID,
+		NamespaceVersion: uint32(namespace.Version),
+		RowProof:         rowProof,
+		Rows:             rows,
+	}, nil
+}
+
+// Shares returns all the shares of the namespace contained in the proof. They
+// are empty if the proof is an absence proof.
+func (p NamespaceProof) Shares() [][]byte {
+	var data [][]byte
+	for _, row := range p.Rows {
+		data = append(data, row.Shares...)
+	}
+	return data
+}
+
+// Validate runs basic validations on the proof then verifies that it proves
+// all the shares of the namespace, or the absence of the namespace, in the
+// data square committed to by root. It returns nil if the proof is valid.
+func (p NamespaceProof) Validate(root []byte) error {
+	namespace, err := appns.New(uint8(p.NamespaceVersion), p.NamespaceId)
+	if err != nil {
+		return err
+	}
+	squareSize, err := verifyRowProof(p.RowProof, root)
+	if err != nil {
+		return err
+	}
+	numRows := len(p.RowProof.RowRoots)
+	if len(p.Rows) != numRows {
+		return fmt.Errorf("the number of row namespace proofs %d must equal the number of row roots %d", len(p.Rows), numRows)
+	}
+
+	// verify that the rows directly before and after the proven rows don't
+	// contain the namespace. Rows further away can't contain it either since
+	// the shares of the square are ordered by namespace.
+	if p.RowProof.StartRow > 0 && bytes.Compare(maxNamespace(p.RowProof.RowRoots[0]), namespace.Bytes()) >= 0 {
+		return fmt.Errorf("row %d before the proven rows may contain the namespace", p.RowProof.StartRow)
+	}
+	if int64(p.RowProof.EndRow) < squareSize-1 && bytes.Compare(minNamespace(p.RowProof.RowRoots[numRows-1]), namespace.Bytes()) <= 0 {
+		return fmt.Errorf("row %d after the proven rows may contain the namespace", p.RowProof.EndRow)
+	}
+
+	// verify that each row contains exactly the provided shares of the namespace
+	for i, row := range p.Rows {
+		if row == nil || row.Proof == nil {
+			return fmt.Errorf("row namespace proof %d cannot be nil", i)
+		}
+		leaves := make([][]byte, len(row.Shares))
+		for j, share := range row.Shares {
+			leaves[j] = append(namespace.Bytes(), share...)
+		}
+		if !toNMTProof(row.Proof).VerifyNamespace(appconsts.NewBaseHashFunc(), namespace.Bytes(), leaves, p.RowProof.RowRoots[i]) {
+			return fmt.Errorf("namespace proof failed to verify for row %d", int(p.RowProof.StartRow)+i)
+		}
+	}
+
+	return nil
+}
+
+// verifyRowProof runs basic validations on the row proof then verifies that
+// the rows exist in the data square committed to by root. It returns the size
+// of the original data square.
+func verifyRowProof(p *RowProof, root []byte) (int64, error) {
+	if p == nil {
+		return 0, errors.New("row proof cannot be nil")
+	}
+	if p.EndRow < p.StartRow {
+		return 0, fmt.Errorf("end row %d cannot be lower than start row %d", p.EndRow, p.StartRow)
+	}
+	numRows := int(p.EndRow-p.StartRow) + 1
+	if len(p.RowRoots) != numRows {
+		return 0, fmt.Errorf("the number of rows %d must equal the number of row roots %d", numRows, len(p.RowRoots))
+	}
+	if len(p.Proofs) != numRows {
+		return 0, fmt.Errorf("the number of proofs %d must equal the number of row roots %d", len(p.Proofs), numRows)
+	}
+
+	// The data root commits to both the row and column roots of the extended
+	// data square, so the total is four times the size of the original square.
+	var squareSize int64
+	for i, pRowProof := range p.Proofs {
+		rowProof, err := merkle.ProofFromProto(pRowProof)
+		if err != nil {
+			return 0, err
+		}
+		if rowProof.Index != int64(p.StartRow)+int64(i) {
+			return 0, fmt.Errorf("row proof %d has index %d but should have %d", i, rowProof.Index, int64(p.StartRow)+int64(i))
+		}
+		if i == 0 {
+			squareSize = rowProof.Total / 4
+		}
+		if rowProof.Total != 4*squareSize {
+			return 0, fmt.Errorf("row proof %d has a total of %d but should have %d", i, rowProof.Total, 4*squareSize)
+		}
+		if err := rowProof.Verify(root, p.RowRoots[i]); err != nil {
+			return 0, err
+		}
+	}
+	if int64(p.EndRow) >= squareSize {
+		return 0, fmt.Errorf("end row %d is outside of the original data square of size %d", p.EndRow, squareSize)
+	}
+	return squareSize, nil
+}
+
+// toNMTProof converts the protobuf representation of an NMT proof, as created
+// by an ErasuredNamespacedMerkleTree, into an nmt.Proof.
+func toNMTProof(proof *NMTProof) nmt.Proof {
+	switch {
+	case len(proof.LeafHash) > 0:
+		return nmt.NewAbsenceProof(int(proof.Start), int(proof.End), proof.Nodes, proof.LeafHash, true)
+	case proof.Start == proof.End:
+		return nmt.NewEmptyRangeProof(true)
+	default:
+		return nmt.NewInclusionProof(int(proof.Start), int(proof.End), proof.Nodes, true)
+	}
+}
+
+func minNamespace(root []byte) []byte {
+	return nmt.MinNamespace(root, appconsts.NamespaceSize)
+}
+
+func maxNamespace(root []byte) []byte {
+	return nmt.MaxNamespace(root, appconsts.NamespaceSize)
+}
@@ -0,0 +1,143 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNamespaceProof(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+	ns3 := appns.MustNewV0(bytes.Repeat([]byte{3}, appns.NamespaceVersionZeroIDSize))
+	ns4 := appns.MustNewV0(bytes.Repeat([]byte{4}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1, ns3, ns1}, []int{5000, 500, 20000})
+	txs := testfactory.GenerateRandomTxs(50, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	type test struct {
+		name      string
+		namespace appns.Namespace
+		absent    bool
+	}
+	tests := []test{
+		{
+			name:      "transaction namespace",
+			namespace: appns.TxNamespace,
+		},
+		{
+			name:      "pay for blob namespace",
+			namespace: appns.PayForBlobNamespace,
+		},
+		{
+			name:      "blob namespace spanning multiple rows",
+			namespace: ns1,
+		},
+		{
+			name:      "blob namespace in a single row",
+			namespace: ns3,
+		},
+		{
+			name:      "absent namespace between blob namespaces",
+			namespace: ns2,
+			absent:    true,
+		},
+		{
+			name:      "absent namespace after blob namespaces",
+			namespace: ns4,
+			absent:    true,
+		},
+		{
+			name:      "namespace lower than every share",
+			namespace: appns.MustNew(appns.NamespaceVersionZero, bytes.Repeat([]byte{0}, appns.NamespaceIDSize)),
+			absent:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nsProof, err := proof.NewNamespaceProof(dataSquare, tt.namespace)
+			require.NoError(t, err)
+			assert.NoError(t, nsProof.Validate(dataRoot))
+
+			shareRange, err := shares.GetShareRangeForNamespace(dataSquare, tt.namespace)
+			require.NoError(t, err)
+			if tt.absent {
+				assert.True(t, shareRange.IsEmpty())
+				assert.Empty(t, nsProof.Shares())
+			} else {
+				assert.Equal(t, shares.ToBytes(dataSquare[shareRange.Start:shareRange.End]), nsProof.Shares())
+			}
+
+			// the proof must not verify against a different data root
+			assert.Error(t, nsProof.Validate(dah.RowRoots[0]))
+		})
+	}
+}
+
+func TestNamespaceProofIncomplete(t *testing.T) {
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns1}, []int{20000})
+	txs := testfactory.GenerateRandomTxs(20, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.LatestVersion, appconsts.SquareSizeUpperBound(appconsts.LatestVersion))
+	require.NoError(t, err)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	dataRoot := dah.Hash()
+
+	nsProof, err := proof.NewNamespaceProof(dataSquare, ns1)
+	require.NoError(t, err)
+	require.NoError(t, nsProof.Validate(dataRoot))
+	require.Greater(t, len(nsProof.Rows), 2)
+
+	// dropping a share of the namespace from a row fails verification
+	dropShare := nsProof
+	dropShare.Rows = append([]*proof.RowNamespaceProof{}, nsProof.Rows...)
+	dropShare.Rows[1] = &proof.RowNamespaceProof{
+		Shares: nsProof.Rows[1].Shares[1:],
+		Proof:  nsProof.Rows[1].Proof,
+	}
+	assert.Error(t, dropShare.Validate(dataRoot))
+
+	// dropping the last row of the proof fails verification
+	dropRow := nsProof
+	dropRow.Rows = nsProof.Rows[:len(nsProof.Rows)-1]
+	dropRow.RowProof = &proof.RowProof{
+		RowRoots: nsProof.RowProof.RowRoots[:len(nsProof.Rows)-1],
+		Proofs:   nsProof.RowProof.Proofs[:len(nsProof.Rows)-1],
+		StartRow: nsProof.RowProof.StartRow,
+		EndRow:   nsProof.RowProof.EndRow - 1,
+	}
+	assert.Error(t, dropRow.Validate(dataRoot))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -60,29 +61,41 @@ func NewShareInclusionProof(
 	namespace appns.Namespace,
 	shareRange shares.Range,
 ) (types.ShareProof, error) {
-	squareSize := dataSquare.Size()
-	startRow := shareRange.Start / squareSize
-	endRow := (shareRange.End - 1) / squareSize
-	startLeaf := shareRange.Start % squareSize
-	endLeaf := (shareRange.End - 1) % squareSize
-
 	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
 	if err != nil {
 		return types.ShareProof{}, err
 	}
-
-	edsRowRoots, err := eds.RowRoots()
+	dah, err := da.NewDataAvailabilityHeader(eds)
 	if err != nil {
 		return types.ShareProof{}, err
 	}
+	return NewShareInclusionProofFromEDS(eds, dah, namespace, shareRange)
+}
 
-	edsColRoots, err := eds.ColRoots()
-	if err != nil {
-		return types.ShareProof{}, err
-	}
+// NewShareInclusionProofFromEDS returns an NMT inclusion proof for a set of
+// shares belonging to the same namespace to the data root, using the already
+// extended data square and its data availability header.
+// Expects the share range to be pre-validated.
+func NewShareInclusionProofFromEDS(
+	eds *rsmt2d.ExtendedDataSquare,
+	dah da.DataAvailabilityHeader,
+	namespace appns.Namespace,
+	shareRange shares.Range,
+) (types.ShareProof, error) {
+	squareSize := int(eds.Width() / 2)
+	startRow := shareRange.Start / squareSize
+	endRow := (shareRange.End - 1) / squareSize
+	startLeaf := shareRange.Start % squareSize
+	endLeaf := (shareRange.End - 1) % squareSize
+
+	edsRowRoots := dah.RowRoots
+	edsColRoots := dah.ColumnRoots
 
 	// create the binary merkle inclusion proof for all the square rows to the data root
-	_, allProofs := merkle.ProofsFromByteSlices(append(edsRowRoots, edsColRoots...))
+	// the roots are copied so that appending doesn't modify the header
+	roots := make([][]byte, 0, len(edsRowRoots)+len(edsColRoots))
+	roots = append(append(roots, edsRowRoots...), edsColRoots...)
+	_, allProofs := merkle.ProofsFromByteSlices(roots)
 	rowProofs := make([]*merkle.Proof, endRow-startRow+1)
 	rowRoots := make([]tmbytes.HexBytes, endRow-startRow+1)
 	for i := startRow; i <= endRow; i++ {
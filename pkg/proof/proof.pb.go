@@ -262,10 +262,320 @@ func (m *NMTProof) GetLeafHash() []byte {
 	return nil
 }
 
+// NamespaceProof is a proof of all the shares of a namespace in a data square.
+// If the namespace has no shares in the square, it proves the absence of the
+// namespace instead. The proof covers every row whose namespace range includes
+// the namespace along with the rows directly before and after them, so that
+// its completeness can be verified using only the data root.
+type NamespaceProof struct {
+	NamespaceId      []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32 `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// row_proof is a Merkle proof that the rows covered by this proof exist in
+	// a Merkle tree with a given data root.
+	RowProof *RowProof `protobuf:"bytes,3,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+	// rows contains one RowNamespaceProof for each row in row_proof.
+	Rows []*RowNamespaceProof `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *NamespaceProof) Reset()         { *m = NamespaceProof{} }
+func (m *NamespaceProof) String() string { return proto.CompactTextString(m) }
+func (*NamespaceProof) ProtoMessage()    {}
+func (*NamespaceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{3}
+}
+func (m *NamespaceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceProof.Merge(m, src)
+}
+func (m *NamespaceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceProof proto.InternalMessageInfo
+
+func (m *NamespaceProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *NamespaceProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *NamespaceProof) GetRowProof() *RowProof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func (m *NamespaceProof) GetRows() []*RowNamespaceProof {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// RowNamespaceProof is an NMT namespace proof of the shares of a namespace in
+// a single row. The proof is an absence proof if the row's namespace range
+// includes the namespace but the row contains no shares of it, and it is empty
+// if the namespace is outside of the row's namespace range.
+type RowNamespaceProof struct {
+	// shares are the raw shares of the namespace in the row.
+	Shares [][]byte  `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	Proof  *NMTProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *RowNamespaceProof) Reset()         { *m = RowNamespaceProof{} }
+func (m *RowNamespaceProof) String() string { return proto.CompactTextString(m) }
+func (*RowNamespaceProof) ProtoMessage()    {}
+func (*RowNamespaceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{4}
+}
+func (m *RowNamespaceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RowNamespaceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RowNamespaceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RowNamespaceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowNamespaceProof.Merge(m, src)
+}
+func (m *RowNamespaceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RowNamespaceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowNamespaceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowNamespaceProof proto.InternalMessageInfo
+
+func (m *RowNamespaceProof) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *RowNamespaceProof) GetProof() *NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// CommitmentProof is a proof that a blob with a given share commitment exists
+// in a data square. Instead of the blob's shares, it contains the subtree
+// roots used to create the share commitment along with NMT range proofs of
+// those subtree roots to the row roots, so that the share commitment can be
+// recomputed and verified against the data root without the shares.
+type CommitmentProof struct {
+	NamespaceId      []byte `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	NamespaceVersion uint32 `protobuf:"varint,2,opt,name=namespace_version,json=namespaceVersion,proto3" json:"namespace_version,omitempty"`
+	// share_commitment is the share commitment of the blob.
+	ShareCommitment []byte `protobuf:"bytes,3,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// share_start is the index of the first share of the blob in the data
+	// square.
+	ShareStart uint32 `protobuf:"varint,4,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	// share_end is the index of the share following the last share of the blob
+	// in the data square (i.e. it is exclusive).
+	ShareEnd uint32 `protobuf:"varint,5,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+	// subtree_root_threshold is the threshold used to determine the width of
+	// the subtree roots of the share commitment.
+	SubtreeRootThreshold uint32 `protobuf:"varint,6,opt,name=subtree_root_threshold,json=subtreeRootThreshold,proto3" json:"subtree_root_threshold,omitempty"`
+	// row_proof is a Merkle proof that the rows containing the blob exist in a
+	// Merkle tree with a given data root.
+	RowProof *RowProof `protobuf:"bytes,7,opt,name=row_proof,json=rowProof,proto3" json:"row_proof,omitempty"`
+	// rows contains one RowSubtreeRootsProof for each row in row_proof.
+	Rows []*RowSubtreeRootsProof `protobuf:"bytes,8,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (m *CommitmentProof) Reset()         { *m = CommitmentProof{} }
+func (m *CommitmentProof) String() string { return proto.CompactTextString(m) }
+func (*CommitmentProof) ProtoMessage()    {}
+func (*CommitmentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{5}
+}
+func (m *CommitmentProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitmentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitmentProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitmentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitmentProof.Merge(m, src)
+}
+func (m *CommitmentProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitmentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitmentProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitmentProof proto.InternalMessageInfo
+
+func (m *CommitmentProof) GetNamespaceId() []byte {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return nil
+}
+
+func (m *CommitmentProof) GetNamespaceVersion() uint32 {
+	if m != nil {
+		return m.NamespaceVersion
+	}
+	return 0
+}
+
+func (m *CommitmentProof) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *CommitmentProof) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
+}
+
+func (m *CommitmentProof) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
+}
+
+func (m *CommitmentProof) GetSubtreeRootThreshold() uint32 {
+	if m != nil {
+		return m.SubtreeRootThreshold
+	}
+	return 0
+}
+
+func (m *CommitmentProof) GetRowProof() *RowProof {
+	if m != nil {
+		return m.RowProof
+	}
+	return nil
+}
+
+func (m *CommitmentProof) GetRows() []*RowSubtreeRootsProof {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// RowSubtreeRootsProof is an NMT range proof of the subtree roots of a blob in
+// a single row.
+type RowSubtreeRootsProof struct {
+	// subtree_roots are the subtree roots of the blob in the row, ordered from
+	// left to right.
+	SubtreeRoots [][]byte `protobuf:"bytes,1,rep,name=subtree_roots,json=subtreeRoots,proto3" json:"subtree_roots,omitempty"`
+	// proof is the NMT range proof of the shares of the blob in the row. The
+	// subtree roots replace the leaves when verifying it.
+	Proof *NMTProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *RowSubtreeRootsProof) Reset()         { *m = RowSubtreeRootsProof{} }
+func (m *RowSubtreeRootsProof) String() string { return proto.CompactTextString(m) }
+func (*RowSubtreeRootsProof) ProtoMessage()    {}
+func (*RowSubtreeRootsProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e53d87d8fb5ec353, []int{6}
+}
+func (m *RowSubtreeRootsProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RowSubtreeRootsProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RowSubtreeRootsProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RowSubtreeRootsProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowSubtreeRootsProof.Merge(m, src)
+}
+func (m *RowSubtreeRootsProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *RowSubtreeRootsProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowSubtreeRootsProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RowSubtreeRootsProof proto.InternalMessageInfo
+
+func (m *RowSubtreeRootsProof) GetSubtreeRoots() [][]byte {
+	if m != nil {
+		return m.SubtreeRoots
+	}
+	return nil
+}
+
+func (m *RowSubtreeRootsProof) GetProof() *NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ShareProof)(nil), "celestia.core.v1.proof.ShareProof")
 	proto.RegisterType((*RowProof)(nil), "celestia.core.v1.proof.RowProof")
 	proto.RegisterType((*NMTProof)(nil), "celestia.core.v1.proof.NMTProof")
+	proto.RegisterType((*NamespaceProof)(nil), "celestia.core.v1.proof.NamespaceProof")
+	proto.RegisterType((*RowNamespaceProof)(nil), "celestia.core.v1.proof.RowNamespaceProof")
+	proto.RegisterType((*CommitmentProof)(nil), "celestia.core.v1.proof.CommitmentProof")
+	proto.RegisterType((*RowSubtreeRootsProof)(nil), "celestia.core.v1.proof.RowSubtreeRootsProof")
 }
 
 func init() {
@@ -273,34 +583,47 @@ func init() {
 }
 
 var fileDescriptor_e53d87d8fb5ec353 = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6e, 0xd4, 0x30,
-	0x14, 0x1d, 0x37, 0x9d, 0x21, 0x78, 0x52, 0xa9, 0x58, 0x08, 0x2c, 0x10, 0x51, 0x98, 0x55, 0x24,
-	0x54, 0x87, 0xc2, 0x9a, 0x0d, 0x2c, 0x80, 0x05, 0x08, 0x19, 0xc4, 0x82, 0xcd, 0xc8, 0x8d, 0xdd,
-	0x49, 0x44, 0xc7, 0x3f, 0xb2, 0xcd, 0x44, 0xdc, 0x82, 0x3b, 0x70, 0x19, 0x96, 0x5d, 0xb2, 0x44,
-	0x33, 0x57, 0xe0, 0x00, 0xc8, 0xf6, 0x24, 0x05, 0x09, 0x75, 0x13, 0x7d, 0xbf, 0xff, 0xfe, 0x7b,
-	0x7e, 0x3f, 0xc6, 0x8b, 0x5a, 0x5d, 0x28, 0xeb, 0x5a, 0x51, 0xd5, 0x60, 0x54, 0xb5, 0x39, 0xad,
-	0x3a, 0x03, 0x70, 0x1e, 0xbf, 0xac, 0x33, 0xe0, 0x80, 0xdc, 0x19, 0x38, 0xcc, 0x73, 0xd8, 0xe6,
-	0x94, 0x85, 0xee, 0xbd, 0x07, 0x4e, 0x69, 0xa9, 0xcc, 0xba, 0xd5, 0xae, 0xaa, 0xcd, 0xd7, 0xce,
-	0xc1, 0xdf, 0x63, 0x8b, 0xdf, 0x08, 0xe3, 0xf7, 0x8d, 0x30, 0xea, 0x9d, 0x07, 0x09, 0xc1, 0x87,
-	0x52, 0x38, 0x41, 0x51, 0x91, 0x94, 0x19, 0x0f, 0x35, 0x79, 0x81, 0x33, 0xeb, 0x19, 0xcb, 0x30,
-	0x67, 0xe9, 0x41, 0x91, 0x94, 0xf3, 0x27, 0x05, 0xfb, 0xbf, 0x21, 0x7b, 0xfb, 0xe6, 0x43, 0xd0,
-	0xe2, 0x73, 0x3b, 0xea, 0x5a, 0xf2, 0x10, 0x67, 0x5a, 0xac, 0x95, 0xed, 0x44, 0xad, 0x96, 0xad,
-	0xa4, 0x49, 0x81, 0xca, 0x8c, 0xcf, 0x47, 0xec, 0xb5, 0x24, 0xcf, 0xf0, 0x4d, 0x03, 0x7d, 0x74,
-	0xa1, 0x87, 0x05, 0xba, 0xce, 0x84, 0x43, 0x1f, 0x4d, 0x52, 0xb3, 0xaf, 0xc8, 0x23, 0x7c, 0xeb,
-	0xca, 0x61, 0xa3, 0x8c, 0x6d, 0x41, 0xd3, 0x69, 0x81, 0xca, 0x23, 0x7e, 0x3c, 0x36, 0x3e, 0x46,
-	0x7c, 0xf1, 0x1d, 0xe1, 0x74, 0xd0, 0x20, 0xf7, 0xa3, 0xb1, 0x01, 0x70, 0x76, 0x9f, 0xdc, 0xcb,
-	0x72, 0x7f, 0x26, 0x8f, 0xf1, 0xec, 0x9f, 0xdc, 0x94, 0x5d, 0x2d, 0x94, 0xc5, 0x85, 0xb2, 0x78,
-	0x95, 0x3d, 0xcf, 0xef, 0xd0, 0x4b, 0xed, 0x23, 0x86, 0xda, 0x5b, 0x58, 0x27, 0x8c, 0x5b, 0x1a,
-	0xe8, 0x43, 0xb6, 0x23, 0x9e, 0x06, 0x80, 0x43, 0x4f, 0xee, 0xe2, 0x1b, 0x4a, 0xcb, 0xd0, 0x8a,
-	0xf7, 0x9d, 0x29, 0x2d, 0x39, 0xf4, 0x0b, 0x85, 0xd3, 0x61, 0x9b, 0xe4, 0x36, 0x9e, 0x86, 0x01,
-	0x8a, 0x0a, 0x54, 0x4e, 0x79, 0x3c, 0x90, 0x63, 0x9c, 0x28, 0x2d, 0xe9, 0x41, 0xc0, 0x7c, 0xe9,
-	0x79, 0x1a, 0xa4, 0xb2, 0x34, 0x09, 0x41, 0xe2, 0xc1, 0xfb, 0x5f, 0x28, 0x71, 0xbe, 0x6c, 0x84,
-	0x6d, 0x82, 0x7f, 0xc6, 0x53, 0x0f, 0xbc, 0x12, 0xb6, 0x79, 0xfe, 0xf2, 0xc7, 0x36, 0x47, 0x97,
-	0xdb, 0x1c, 0xfd, 0xda, 0xe6, 0xe8, 0xdb, 0x2e, 0x9f, 0x5c, 0xee, 0xf2, 0xc9, 0xcf, 0x5d, 0x3e,
-	0xf9, 0x74, 0xb2, 0x6a, 0x5d, 0xf3, 0xe5, 0x8c, 0xd5, 0xb0, 0xae, 0x86, 0x3f, 0x01, 0x66, 0x35,
-	0xd6, 0x27, 0xa2, 0xeb, 0xaa, 0xee, 0xf3, 0x2a, 0x3e, 0xa9, 0xb3, 0x59, 0x78, 0x53, 0x4f, 0xff,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xf1, 0x1c, 0x57, 0xb0, 0x02, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x4e, 0xd4, 0x40,
+	0x18, 0x67, 0xb6, 0xec, 0xb2, 0x7e, 0x2c, 0x02, 0x13, 0x82, 0x8d, 0xc6, 0x5a, 0xeb, 0x65, 0x89,
+	0xd2, 0x8a, 0x1a, 0x6f, 0x24, 0x46, 0x62, 0xd4, 0x83, 0xc4, 0x0c, 0xc4, 0x83, 0x97, 0xa6, 0xb4,
+	0x03, 0x6d, 0xa4, 0x9d, 0x66, 0x66, 0xa0, 0xf1, 0xe8, 0x1b, 0xf8, 0x0e, 0xbe, 0x8c, 0x47, 0x8e,
+	0x9e, 0x8c, 0x61, 0x5f, 0xc1, 0x07, 0x30, 0x33, 0xd3, 0x76, 0x77, 0x65, 0xb3, 0x09, 0x26, 0x5e,
+	0x9a, 0x6f, 0xbe, 0x7f, 0xbf, 0xf9, 0xbe, 0xdf, 0x6f, 0x0a, 0x5e, 0x4c, 0x4f, 0xa9, 0x90, 0x59,
+	0x14, 0xc4, 0x8c, 0xd3, 0xe0, 0x7c, 0x27, 0x28, 0x39, 0x63, 0xc7, 0xe6, 0xeb, 0x97, 0x9c, 0x49,
+	0x86, 0x37, 0x9b, 0x1c, 0x5f, 0xe5, 0xf8, 0xe7, 0x3b, 0xbe, 0x8e, 0xde, 0xbe, 0x2b, 0x69, 0x91,
+	0x50, 0x9e, 0x67, 0x85, 0x0c, 0x62, 0xfe, 0xb9, 0x94, 0x6c, 0xb2, 0xcc, 0xfb, 0x8d, 0x00, 0x0e,
+	0xd2, 0x88, 0xd3, 0xf7, 0xca, 0x89, 0x31, 0x2c, 0x26, 0x91, 0x8c, 0x6c, 0xe4, 0x5a, 0xc3, 0x01,
+	0xd1, 0x36, 0xde, 0x83, 0x81, 0x50, 0x19, 0xa1, 0xae, 0x13, 0x76, 0xc7, 0xb5, 0x86, 0xcb, 0x4f,
+	0x5c, 0x7f, 0x36, 0xa0, 0xbf, 0xff, 0xee, 0x50, 0xf7, 0x22, 0xcb, 0xa2, 0xed, 0x2b, 0xf0, 0x7d,
+	0x18, 0x14, 0x51, 0x4e, 0x45, 0x19, 0xc5, 0x34, 0xcc, 0x12, 0xdb, 0x72, 0xd1, 0x70, 0x40, 0x96,
+	0x5b, 0xdf, 0xdb, 0x04, 0xef, 0xc2, 0x0d, 0xce, 0x2a, 0x83, 0x62, 0x2f, 0xba, 0x68, 0x1e, 0x08,
+	0x61, 0x95, 0x01, 0xe9, 0xf3, 0xda, 0xc2, 0x0f, 0x61, 0x7d, 0x8c, 0x70, 0x4e, 0xb9, 0xc8, 0x58,
+	0x61, 0x77, 0x5d, 0x34, 0x5c, 0x21, 0x6b, 0x6d, 0xe0, 0x83, 0xf1, 0x7b, 0xdf, 0x10, 0xf4, 0x9b,
+	0x1e, 0xf8, 0x8e, 0x01, 0xe6, 0x8c, 0x49, 0x51, 0x4f, 0xae, 0xda, 0x12, 0x75, 0xc6, 0x8f, 0xa1,
+	0x37, 0x35, 0xb7, 0xed, 0x8f, 0x17, 0xea, 0x9b, 0x85, 0xfa, 0xe6, 0x2a, 0x75, 0x9e, 0xda, 0xa1,
+	0x6a, 0x55, 0x8f, 0xa8, 0x6d, 0x05, 0x21, 0x64, 0xc4, 0x65, 0xc8, 0x59, 0xa5, 0x67, 0x5b, 0x21,
+	0x7d, 0xed, 0x20, 0xac, 0xc2, 0xb7, 0x60, 0x89, 0x16, 0x89, 0x0e, 0x99, 0xfb, 0xf6, 0x68, 0x91,
+	0x10, 0x56, 0x79, 0x14, 0xfa, 0xcd, 0x36, 0xf1, 0x06, 0x74, 0x75, 0x81, 0x8d, 0x5c, 0x34, 0xec,
+	0x12, 0x73, 0xc0, 0x6b, 0x60, 0xd1, 0x22, 0xb1, 0x3b, 0xda, 0xa7, 0x4c, 0x95, 0x57, 0xb0, 0x84,
+	0x0a, 0xdb, 0xd2, 0x83, 0x98, 0x83, 0xc2, 0x3f, 0xa5, 0xd1, 0x71, 0x98, 0x46, 0x22, 0xd5, 0xf8,
+	0x03, 0xd2, 0x57, 0x8e, 0x37, 0x91, 0x48, 0xbd, 0x9f, 0x08, 0x6e, 0xee, 0x37, 0x1b, 0x32, 0x68,
+	0x7f, 0xd3, 0x85, 0xae, 0xd2, 0x35, 0x73, 0xdf, 0x9d, 0xd9, 0xfb, 0x9e, 0xe6, 0xd6, 0xba, 0x36,
+	0xb7, 0xbb, 0x6a, 0xa5, 0x95, 0xb0, 0x17, 0x35, 0x05, 0x5b, 0x73, 0x2a, 0xa7, 0xe7, 0x20, 0xba,
+	0xcc, 0x8b, 0x61, 0xfd, 0x4a, 0x08, 0x6f, 0x42, 0x4f, 0x0b, 0xb4, 0xa1, 0xbc, 0x3e, 0xe1, 0xe7,
+	0xd0, 0x35, 0xd7, 0xec, 0xcc, 0xbf, 0x66, 0xab, 0x73, 0x93, 0xee, 0x7d, 0xb1, 0x60, 0x75, 0x8f,
+	0xe5, 0x79, 0x26, 0x73, 0x5a, 0xc8, 0xff, 0xb3, 0xc6, 0x2d, 0x58, 0x33, 0x4f, 0x31, 0x6e, 0x81,
+	0x6a, 0x99, 0xad, 0x6a, 0xff, 0x18, 0x1f, 0xdf, 0x03, 0xf3, 0xfe, 0x42, 0xa3, 0x1a, 0xa3, 0x39,
+	0xd0, 0xae, 0x03, 0x2d, 0x1d, 0x25, 0x49, 0x9d, 0xa0, 0x04, 0xd4, 0xad, 0x25, 0xa9, 0x1c, 0xaf,
+	0x8a, 0x04, 0x3f, 0x83, 0x4d, 0x71, 0x76, 0x24, 0x39, 0xa5, 0xfa, 0x59, 0x84, 0x32, 0xe5, 0x54,
+	0xa4, 0xec, 0x34, 0xb1, 0x7b, 0x3a, 0x73, 0xa3, 0x8e, 0xaa, 0x37, 0x72, 0xd8, 0xc4, 0xa6, 0x59,
+	0x5e, 0xba, 0x36, 0xcb, 0x2f, 0x6a, 0x96, 0xfb, 0x9a, 0xe5, 0x47, 0x73, 0x2a, 0x0f, 0xc6, 0xe8,
+	0x62, 0x92, 0x68, 0x01, 0x1b, 0xb3, 0xa2, 0xf8, 0x01, 0xac, 0x4c, 0x8e, 0xd3, 0x50, 0x3e, 0x98,
+	0x98, 0xe2, 0x9f, 0x89, 0x7f, 0xf9, 0xfa, 0xfb, 0xa5, 0x83, 0x2e, 0x2e, 0x1d, 0xf4, 0xeb, 0xd2,
+	0x41, 0x5f, 0x47, 0xce, 0xc2, 0xc5, 0xc8, 0x59, 0xf8, 0x31, 0x72, 0x16, 0x3e, 0x6e, 0x9f, 0x64,
+	0x32, 0x3d, 0x3b, 0xf2, 0x63, 0x96, 0x07, 0x4d, 0x33, 0xc6, 0x4f, 0x5a, 0x7b, 0x3b, 0x2a, 0xcb,
+	0xa0, 0xfc, 0x74, 0x62, 0xfe, 0xc8, 0x47, 0x3d, 0xfd, 0x4b, 0x7e, 0xfa, 0x27, 0x00, 0x00, 0xff,
+	0xff, 0x4a, 0x02, 0x4a, 0xa6, 0xef, 0x05, 0x00, 0x00,
 }
 
 func (m *ShareProof) Marshal() (dAtA []byte, err error) {
@@ -485,59 +808,291 @@ func (m *NMTProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProof(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NamespaceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ShareProof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *NamespaceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, b := range m.Data {
-			l = len(b)
-			n += 1 + l + sovProof(uint64(l))
-		}
-	}
-	if len(m.ShareProofs) > 0 {
-		for _, e := range m.ShareProofs {
-			l = e.Size()
-			n += 1 + l + sovProof(uint64(l))
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovProof(uint64(l))
-	}
 	if m.RowProof != nil {
-		l = m.RowProof.Size()
-		n += 1 + l + sovProof(uint64(l))
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.NamespaceVersion != 0 {
-		n += 1 + sovProof(uint64(m.NamespaceVersion))
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RowProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RowNamespaceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RowRoots) > 0 {
-		for _, b := range m.RowRoots {
-			l = len(b)
-			n += 1 + l + sovProof(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *RowNamespaceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RowNamespaceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommitmentProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitmentProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitmentProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rows) > 0 {
+		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProof(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.RowProof != nil {
+		{
+			size, err := m.RowProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SubtreeRootThreshold != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.SubtreeRootThreshold))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ShareEnd != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NamespaceVersion != 0 {
+		i = encodeVarintProof(dAtA, i, uint64(m.NamespaceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintProof(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RowSubtreeRootsProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RowSubtreeRootsProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RowSubtreeRootsProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SubtreeRoots) > 0 {
+		for iNdEx := len(m.SubtreeRoots) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SubtreeRoots[iNdEx])
+			copy(dAtA[i:], m.SubtreeRoots[iNdEx])
+			i = encodeVarintProof(dAtA, i, uint64(len(m.SubtreeRoots[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ShareProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if len(m.ShareProofs) > 0 {
+		for _, e := range m.ShareProofs {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	return n
+}
+
+func (m *RowProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RowRoots) > 0 {
+		for _, b := range m.RowRoots {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
 		}
 	}
 	if len(m.Proofs) > 0 {
@@ -584,6 +1139,109 @@ func (m *NMTProof) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RowNamespaceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
+func (m *CommitmentProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.NamespaceVersion != 0 {
+		n += 1 + sovProof(uint64(m.NamespaceVersion))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovProof(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovProof(uint64(m.ShareEnd))
+	}
+	if m.SubtreeRootThreshold != 0 {
+		n += 1 + sovProof(uint64(m.SubtreeRootThreshold))
+	}
+	if m.RowProof != nil {
+		l = m.RowProof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RowSubtreeRootsProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SubtreeRoots) > 0 {
+		for _, b := range m.SubtreeRoots {
+			l = len(b)
+			n += 1 + l + sovProof(uint64(l))
+		}
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovProof(uint64(l))
+	}
+	return n
+}
+
 func sovProof(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -677,17 +1335,671 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareProofs = append(m.ShareProofs, &NMTProof{})
+			if err := m.ShareProofs[len(m.ShareProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoots", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowRoots = append(m.RowRoots, make([]byte, postIndex-iNdEx))
+			copy(m.RowRoots[len(m.RowRoots)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &crypto.Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartRow", wireType)
+			}
+			m.StartRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndRow", wireType)
+			}
+			m.EndRow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndRow |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NMTProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NMTProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NMTProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
+			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
+			}
+			m.NamespaceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &RowNamespaceProof{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProof
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShareProofs = append(m.ShareProofs, &NMTProof{})
-			if err := m.ShareProofs[len(m.ShareProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowNamespaceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProof
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowNamespaceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowNamespaceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -714,14 +2026,12 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
-			if m.NamespaceId == nil {
-				m.NamespaceId = []byte{}
-			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -748,32 +2058,13 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RowProof == nil {
-				m.RowProof = &RowProof{}
+			if m.Proof == nil {
+				m.Proof = &NMTProof{}
 			}
-			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
-			}
-			m.NamespaceVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NamespaceVersion |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -795,7 +2086,7 @@ func (m *ShareProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RowProof) Unmarshal(dAtA []byte) error {
+func (m *CommitmentProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -818,15 +2109,15 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RowProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitmentProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RowProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitmentProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowRoots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -853,14 +2144,16 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RowRoots = append(m.RowRoots, make([]byte, postIndex-iNdEx))
-			copy(m.RowRoots[len(m.RowRoots)-1], dAtA[iNdEx:postIndex])
+			m.NamespaceId = append(m.NamespaceId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NamespaceId == nil {
+				m.NamespaceId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceVersion", wireType)
 			}
-			var msglen int
+			m.NamespaceVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -870,29 +2163,14 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NamespaceVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProof
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProof
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proofs = append(m.Proofs, &crypto.Proof{})
-			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -919,16 +2197,16 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartRow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
 			}
-			m.StartRow = 0
+			m.ShareStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -938,16 +2216,16 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartRow |= uint32(b&0x7F) << shift
+				m.ShareStart |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndRow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
 			}
-			m.EndRow = 0
+			m.ShareEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -957,11 +2235,100 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndRow |= uint32(b&0x7F) << shift
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeRootThreshold", wireType)
+			}
+			m.SubtreeRootThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubtreeRootThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RowProof == nil {
+				m.RowProof = &RowProof{}
+			}
+			if err := m.RowProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows, &RowSubtreeRootsProof{})
+			if err := m.Rows[len(m.Rows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProof(dAtA[iNdEx:])
@@ -983,7 +2350,7 @@ func (m *RowProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NMTProof) Unmarshal(dAtA []byte) error {
+func (m *RowSubtreeRootsProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,53 +2373,15 @@ func (m *NMTProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NMTProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: RowSubtreeRootsProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NMTProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RowSubtreeRootsProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
-			}
-			m.Start = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Start |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
-			}
-			m.End = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProof
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.End |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubtreeRoots", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1079,14 +2408,14 @@ func (m *NMTProof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, make([]byte, postIndex-iNdEx))
-			copy(m.Nodes[len(m.Nodes)-1], dAtA[iNdEx:postIndex])
+			m.SubtreeRoots = append(m.SubtreeRoots, make([]byte, postIndex-iNdEx))
+			copy(m.SubtreeRoots[len(m.SubtreeRoots)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProof
@@ -1096,24 +2425,26 @@ func (m *NMTProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProof
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProof
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.LeafHash == nil {
-				m.LeafHash = []byte{}
+			if m.Proof == nil {
+				m.Proof = &NMTProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
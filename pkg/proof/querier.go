@@ -2,10 +2,12 @@ package proof
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 
@@ -66,56 +68,82 @@ const ShareInclusionQueryPath = "shareInclusionProof"
 // inclusion proofs of a set of shares to the data root. The share range should
 // be appended to the path. Example path for proving the set of shares [3, 5]:
 // custom/shareInclusionProof/3/5
-func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
-	// parse the share range from the path
-	if len(path) != 2 {
-		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
-	}
-	beginShare, err := strconv.ParseInt(path[0], 10, 64)
-	if err != nil {
-		return nil, err
-	}
-	endShare, err := strconv.ParseInt(path[1], 10, 64)
-	if err != nil {
-		return nil, err
-	}
+func QueryShareInclusionProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewShareInclusionProofQuerier(nil)(ctx, path, req)
+}
 
-	// unmarshal the block data that is passed from the ABCI client
-	pbb := new(tmproto.Block)
-	err = pbb.Unmarshal(req.Data)
-	if err != nil {
-		return nil, fmt.Errorf("error reading block: %w", err)
-	}
+// NewShareInclusionProofQuerier returns a QueryShareInclusionProof querier
+// that reuses the extended data square of the block from the cache, if any,
+// instead of erasure coding the data square again.
+func NewShareInclusionProofQuerier(cache *da.EDSCache) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		// parse the share range from the path
+		if len(path) != 2 {
+			return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
+		}
+		beginShare, err := strconv.ParseInt(path[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		endShare, err := strconv.ParseInt(path[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
 
-	// construct the data square from the block data. As we don't have
-	// access to the application's state machine we use the upper bound
-	// square size instead of the square size dictated from governance
-	dataSquare, err := square.Construct(pbb.Data.Txs, pbb.Header.Version.App, appconsts.SquareSizeUpperBound(pbb.Header.Version.App))
-	if err != nil {
-		return nil, err
-	}
+		// unmarshal the block data that is passed from the ABCI client
+		pbb := new(tmproto.Block)
+		err = pbb.Unmarshal(req.Data)
+		if err != nil {
+			return nil, fmt.Errorf("error reading block: %w", err)
+		}
 
-	nID, err := ParseNamespace(dataSquare, int(beginShare), int(endShare))
-	if err != nil {
-		return nil, err
-	}
+		// create the share inclusion proof from the extended data square of
+		// the block if it is cached, which avoids constructing the data
+		// square and erasure coding it again
+		var shareProof types.ShareProof
+		shareRange := shares.NewRange(int(beginShare), int(endShare))
+		if eds, dah, ok := cache.Get(pbb.Header.Height, pbb.Header.DataHash); ok {
+			dataSquare, err := shares.FromBytes(eds.FlattenedODS())
+			if err != nil {
+				return nil, err
+			}
+			nID, err := ParseNamespace(dataSquare, int(beginShare), int(endShare))
+			if err != nil {
+				return nil, err
+			}
+			shareProof, err = NewShareInclusionProofFromEDS(eds, dah, nID, shareRange)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// construct the data square from the block data. As we don't
+			// have access to the application's state machine we use the
+			// upper bound square size instead of the square size dictated
+			// from governance
+			dataSquare, err := square.Construct(pbb.Data.Txs, pbb.Header.Version.App, appconsts.SquareSizeUpperBound(pbb.Header.Version.App))
+			if err != nil {
+				return nil, err
+			}
+			nID, err := ParseNamespace(dataSquare, int(beginShare), int(endShare))
+			if err != nil {
+				return nil, err
+			}
+			shareProof, err = NewShareInclusionProof(dataSquare, nID, shareRange)
+			if err != nil {
+				return nil, err
+			}
+		}
 
-	// create and marshal the share inclusion proof, which we return in the form of []byte
-	shareProof, err := NewShareInclusionProof(
-		dataSquare,
-		nID,
-		shares.NewRange(int(beginShare), int(endShare)),
-	)
-	if err != nil {
-		return nil, err
-	}
-	pShareProof := shareProof.ToProto()
-	rawShareProof, err := pShareProof.Marshal()
-	if err != nil {
-		return nil, err
-	}
+		// marshal the share inclusion proof, which we return in the form of
+		// []byte
+		pShareProof := shareProof.ToProto()
+		rawShareProof, err := pShareProof.Marshal()
+		if err != nil {
+			return nil, err
+		}
 
-	return rawShareProof, nil
+		return rawShareProof, nil
+	}
 }
 
 // ParseNamespace validates the share range, checks if it only contains one namespace and returns
@@ -153,3 +181,53 @@ func ParseNamespace(rawShares []shares.Share, startShare, endShare int) (appns.N
 	}
 	return startShareNs, nil
 }
+
+const NamespaceQueryPath = "namespaceProof"
+
+// QueryNamespaceProof defines the logic performed when querying for a proof of
+// all the shares of a namespace in a block, or of the absence of the namespace
+// if the block doesn't contain any of its shares. The hex encoded namespace
+// should be appended to the path. Example path for proving the namespace
+// 0x00...0102:
+// custom/namespaceProof/00000000000000000000000000000000000000000000000102
+func QueryNamespaceProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	// parse the namespace from the path
+	if len(path) != 1 {
+		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
+	}
+	rawNamespace, err := hex.DecodeString(path[0])
+	if err != nil {
+		return nil, err
+	}
+	namespace, err := appns.From(rawNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmarshal the block data that is passed from the ABCI client
+	pbb := new(tmproto.Block)
+	err = pbb.Unmarshal(req.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error reading block: %w", err)
+	}
+
+	// construct the data square from the block data. As we don't have
+	// access to the application's state machine we use the upper bound
+	// square size instead of the square size dictated from governance
+	dataSquare, err := square.Construct(pbb.Data.Txs, pbb.Header.Version.App, appconsts.SquareSizeUpperBound(pbb.Header.Version.App))
+	if err != nil {
+		return nil, err
+	}
+
+	// create and marshal the namespace proof, which we return in the form of []byte
+	namespaceProof, err := NewNamespaceProof(dataSquare, namespace)
+	if err != nil {
+		return nil, err
+	}
+	rawNamespaceProof, err := namespaceProof.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return rawNamespaceProof, nil
+}
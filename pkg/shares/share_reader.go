@@ -0,0 +1,48 @@
+package shares
+
+import (
+	"io"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+)
+
+// ShareReader reads shares one at a time from an underlying io.Reader
+// containing the concatenation of the bytes of the shares.
+type ShareReader struct {
+	r io.Reader
+	// count is the number of shares read so far.
+	count int
+}
+
+// NewShareReader returns a ShareReader reading shares from r.
+func NewShareReader(r io.Reader) *ShareReader {
+	return &ShareReader{r: r}
+}
+
+// ReadShare reads the next share. It returns io.EOF if there are no more
+// shares and io.ErrUnexpectedEOF if the reader ends in the middle of a share.
+func (sr *ShareReader) ReadShare() (Share, error) {
+	data := make([]byte, appconsts.ShareSize)
+	if _, err := io.ReadFull(sr.r, data); err != nil {
+		return Share{}, err
+	}
+	sr.count++
+	return Share{data: data}, nil
+}
+
+// Count returns the number of shares read so far.
+func (sr *ShareReader) Count() int {
+	return sr.count
+}
+
+// writeShare writes the bytes of the share to w.
+func writeShare(w io.Writer, share *Share) error {
+	n, err := w.Write(share.ToBytes())
+	if err != nil {
+		return err
+	}
+	if n != share.Len() {
+		return io.ErrShortWrite
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package shares
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// CompactShareWriter is the streaming counterpart of CompactShareSplitter. It
+// writes units (transactions) compactly across shares and writes each share to
+// the underlying io.Writer as soon as it is full, so that only a single share
+// is held in memory. The bytes written are identical to the bytes of the shares
+// exported by a CompactShareSplitter to which the same transactions are
+// written.
+//
+// Since the first share contains the sequence length, the sequence length must
+// be known before writing the first share. It is the sum of the lengths of the
+// delimited transactions, see CompactSequenceLen.
+type CompactShareWriter struct {
+	w            io.Writer
+	shareBuilder *Builder
+	namespace    appns.Namespace
+	shareVersion uint8
+	sequenceLen  uint32
+	// written is the number of bytes of delimited transactions written.
+	written uint64
+	count   int
+	closed  bool
+}
+
+// NewCompactShareWriter returns a CompactShareWriter writing shares of the
+// provided namespace and share version to w. sequenceLen is the total length
+// of the delimited transactions that will be written.
+func NewCompactShareWriter(w io.Writer, ns appns.Namespace, shareVersion uint8, sequenceLen uint32) (*CompactShareWriter, error) {
+	sb, err := NewBuilder(ns, shareVersion, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := sb.WriteSequenceLen(sequenceLen); err != nil {
+		return nil, err
+	}
+	return &CompactShareWriter{
+		w:            w,
+		shareBuilder: sb,
+		namespace:    ns,
+		shareVersion: shareVersion,
+		sequenceLen:  sequenceLen,
+	}, nil
+}
+
+// CompactSequenceLen returns the sequence length of the compact shares
+// containing the provided transactions.
+func CompactSequenceLen(txs ...coretypes.Tx) uint32 {
+	var sequenceLen uint32
+	for _, tx := range txs {
+		sequenceLen += uint32(DelimLen(uint64(len(tx))) + len(tx))
+	}
+	return sequenceLen
+}
+
+// WriteTx writes the delimited data for the provided tx.
+func (csw *CompactShareWriter) WriteTx(tx coretypes.Tx) error {
+	if csw.closed {
+		return errors.New("compact share writer is closed")
+	}
+	rawData, err := MarshalDelimitedTx(tx)
+	if err != nil {
+		return fmt.Errorf("included Tx in mem-pool that can not be encoded %v", tx)
+	}
+	if csw.written+uint64(len(rawData)) > uint64(csw.sequenceLen) {
+		return fmt.Errorf("writing %d bytes exceeds the sequence length %d", len(rawData), csw.sequenceLen)
+	}
+	csw.written += uint64(len(rawData))
+
+	if err := csw.shareBuilder.MaybeWriteReservedBytes(); err != nil {
+		return err
+	}
+	for {
+		rawDataLeftOver := csw.shareBuilder.AddData(rawData)
+		if rawDataLeftOver == nil {
+			break
+		}
+		if err := csw.flushPending(); err != nil {
+			return err
+		}
+		rawData = rawDataLeftOver
+	}
+
+	if csw.shareBuilder.AvailableBytes() == 0 {
+		return csw.flushPending()
+	}
+	return nil
+}
+
+// Close writes the last, zero padded, share. It returns an error if the
+// transactions written don't add up to the sequence length.
+func (csw *CompactShareWriter) Close() error {
+	if csw.closed {
+		return nil
+	}
+	if csw.written != uint64(csw.sequenceLen) {
+		return fmt.Errorf("wrote %d bytes but the sequence length is %d", csw.written, csw.sequenceLen)
+	}
+	csw.closed = true
+	if csw.shareBuilder.IsEmptyShare() {
+		return nil
+	}
+	csw.shareBuilder.ZeroPadIfNecessary()
+	return csw.flushPending()
+}
+
+// Count returns the number of shares written so far.
+func (csw *CompactShareWriter) Count() int {
+	return csw.count
+}
+
+// flushPending builds and writes the pending share.
+func (csw *CompactShareWriter) flushPending() error {
+	pendingShare, err := csw.shareBuilder.Build()
+	if err != nil {
+		return err
+	}
+	if err := writeShare(csw.w, pendingShare); err != nil {
+		return err
+	}
+	csw.count++
+
+	csw.shareBuilder, err = NewBuilder(csw.namespace, csw.shareVersion, false)
+	return err
+}
+
+// CompactShareReader is the streaming counterpart of ParseTxs. It reads
+// compact shares one at a time from an underlying io.Reader and returns the
+// units (transactions) they contain one at a time, so that at most one share
+// and one unit are held in memory. The units are identical to the units
+// returned by ParseTxs for the same shares. Unlike ParseTxs, the units that
+// precede an invalid share or delimiter are returned before the error.
+type CompactShareReader struct {
+	raw *bufio.Reader
+	// done is whether the rest of the raw data is padding.
+	done bool
+}
+
+// NewCompactShareReader returns a CompactShareReader reading shares from r.
+func NewCompactShareReader(r io.Reader) *CompactShareReader {
+	return &CompactShareReader{
+		raw: bufio.NewReaderSize(&compactRawDataReader{shares: NewShareReader(r)}, appconsts.ContinuationCompactShareContentSize),
+	}
+}
+
+// Next returns the next unit. It returns io.EOF when there are no more units.
+func (csr *CompactShareReader) Next() ([]byte, error) {
+	if csr.done {
+		return nil, io.EOF
+	}
+	// the delimiter is parsed like ParseDelimiter does, which skips the
+	// length of the canonical encoding of the unit length
+	delimiter, err := csr.raw.Peek(binary.MaxVarintLen64)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	padded, _ := zeroPadIfNecessary(append([]byte{}, delimiter...), binary.MaxVarintLen64)
+	unitLen, err := binary.ReadUvarint(bytes.NewReader(padded))
+	if err != nil {
+		return nil, err
+	}
+	// the rest of raw data is padding
+	if unitLen == 0 {
+		csr.done = true
+		return nil, io.EOF
+	}
+	if unitLen > math.MaxInt64 {
+		return nil, fmt.Errorf("unit length %d overflows", unitLen)
+	}
+	if _, err := csr.raw.Discard(DelimLen(unitLen)); err != nil {
+		return nil, err
+	}
+
+	// the unit is copied progressively so that a corrupt unit length doesn't
+	// cause a large allocation
+	var unit bytes.Buffer
+	if _, err := io.CopyN(&unit, csr.raw, int64(unitLen)); err != nil {
+		if errors.Is(err, io.EOF) {
+			// the rest of the raw data contains only part of the next unit
+			csr.done = true
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return unit.Bytes(), nil
+}
+
+// compactRawDataReader reads the raw data of compact shares, starting at the
+// reserved bytes of the first share, see extractRawData.
+type compactRawDataReader struct {
+	shares *ShareReader
+	data   []byte
+}
+
+func (r *compactRawDataReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		share, err := r.shares.ReadShare()
+		if err != nil {
+			return 0, err
+		}
+		if err := share.DoesSupportVersions(appconsts.SupportedShareVersions); err != nil {
+			return 0, err
+		}
+		if r.shares.Count() == 1 {
+			r.data, err = share.RawDataUsingReserved()
+		} else {
+			r.data, err = share.RawData()
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
@@ -0,0 +1,118 @@
+package shares
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestCompactShareWriterAndReader(t *testing.T) {
+	// exactTxShareSize is the length of tx that will fit exactly into a single
+	// share, accounting for the 1 byte tx length delimiter.
+	const exactTxShareSize = appconsts.FirstCompactShareContentSize - 1
+
+	type test struct {
+		name    string
+		txSize  int
+		txCount int
+	}
+	tests := []test{
+		{"no txs", 10, 0},
+		{"single small tx", appconsts.ContinuationCompactShareContentSize / 8, 1},
+		{"many small txs", appconsts.ContinuationCompactShareContentSize / 8, 100},
+		{"many big txs", appconsts.ContinuationCompactShareContentSize * 4, 10},
+		{"single exact size tx", exactTxShareSize, 1},
+		{"many exact size txs", exactTxShareSize, 100},
+	}
+
+	for _, tc := range tests {
+		txsSets := map[string]coretypes.Txs{
+			"identically sized": testfactory.GenerateRandomTxs(tc.txCount, tc.txSize),
+			"randomly sized":    testfactory.GenerateRandomlySizedTxs(tc.txCount, tc.txSize),
+		}
+		for name, txs := range txsSets {
+			t.Run(fmt.Sprintf("%s %s", tc.name, name), func(t *testing.T) {
+				splitter := NewCompactShareSplitter(appns.TxNamespace, appconsts.ShareVersionZero)
+				buf := new(bytes.Buffer)
+				writer, err := NewCompactShareWriter(buf, appns.TxNamespace, appconsts.ShareVersionZero, CompactSequenceLen(txs...))
+				require.NoError(t, err)
+				for _, tx := range txs {
+					require.NoError(t, splitter.WriteTx(tx))
+					require.NoError(t, writer.WriteTx(tx))
+				}
+				require.NoError(t, writer.Close())
+				expected, err := splitter.Export()
+				require.NoError(t, err)
+				assert.Equal(t, len(expected), writer.Count())
+				assert.True(t, bytes.Equal(bytes.Join(ToBytes(expected), nil), buf.Bytes()))
+
+				expectedTxs, err := ParseTxs(expected)
+				require.NoError(t, err)
+				reader := NewCompactShareReader(buf)
+				gotTxs := coretypes.Txs{}
+				for {
+					unit, err := reader.Next()
+					if err == io.EOF {
+						break
+					}
+					require.NoError(t, err)
+					gotTxs = append(gotTxs, unit)
+				}
+				assert.Equal(t, len(expectedTxs), len(gotTxs))
+				for i := range expectedTxs {
+					assert.Equal(t, expectedTxs[i], gotTxs[i])
+				}
+			})
+		}
+	}
+}
+
+func TestCompactShareWriterSequenceLen(t *testing.T) {
+	txs := testfactory.GenerateRandomTxs(2, 100)
+
+	writer, err := NewCompactShareWriter(io.Discard, appns.TxNamespace, appconsts.ShareVersionZero, CompactSequenceLen(txs[0]))
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteTx(txs[0]))
+	assert.Error(t, writer.WriteTx(txs[1]))
+
+	writer, err = NewCompactShareWriter(io.Discard, appns.TxNamespace, appconsts.ShareVersionZero, CompactSequenceLen(txs...))
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteTx(txs[0]))
+	assert.Error(t, writer.Close())
+}
+
+func TestCompactShareReaderOutOfContext(t *testing.T) {
+	txs := testfactory.GenerateRandomlySizedTxs(100, appconsts.ContinuationCompactShareContentSize*2)
+	txShares, _, _, err := SplitTxs(txs)
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		start, length := testfactory.GetRandomSubSlice(len(txShares))
+		subShares := txShares[start : start+length]
+		expected, err := ParseTxs(subShares)
+		require.NoError(t, err)
+
+		reader := NewCompactShareReader(bytes.NewReader(bytes.Join(ToBytes(subShares), nil)))
+		got := coretypes.Txs{}
+		for {
+			unit, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			got = append(got, unit)
+		}
+		assert.Equal(t, len(expected), len(got))
+		for j := range expected {
+			assert.Equal(t, expected[j], got[j])
+		}
+	}
+}
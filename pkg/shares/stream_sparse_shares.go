@@ -0,0 +1,319 @@
+package shares
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"golang.org/x/exp/slices"
+)
+
+// SparseShareWriter is the streaming counterpart of SparseShareSplitter. It
+// splits blobs into shares and writes each share to the underlying io.Writer
+// as soon as it is built, so that only a single share is held in memory. The
+// bytes written are identical to the bytes of the shares exported by a
+// SparseShareSplitter to which the same blobs are written.
+type SparseShareWriter struct {
+	w     io.Writer
+	count int
+	// lastNamespace and lastShareVersion are the namespace and share version
+	// of the last share written, used to write namespace padding shares.
+	lastNamespace    appns.Namespace
+	lastShareVersion uint8
+	// buf holds the blob data read into a share.
+	buf []byte
+}
+
+// NewSparseShareWriter returns a SparseShareWriter writing shares to w.
+func NewSparseShareWriter(w io.Writer) *SparseShareWriter {
+	return &SparseShareWriter{
+		w:   w,
+		buf: make([]byte, appconsts.ContinuationSparseShareContentSize),
+	}
+}
+
+// Write splits the provided blob into shares and writes them.
+func (ssw *SparseShareWriter) Write(blob *blob.Blob) error {
+	if err := blob.Validate(); err != nil {
+		return err
+	}
+	// note by validating the blob we can safely cast the share version to uint8
+	return ssw.WriteFrom(blob.Namespace(), uint8(blob.ShareVersion), uint32(len(blob.Data)), bytes.NewReader(blob.Data))
+}
+
+// WriteFrom splits the size bytes of blob data read from r into shares of the
+// provided namespace and share version, and writes them. It returns an error
+// if r contains fewer than size bytes.
+func (ssw *SparseShareWriter) WriteFrom(ns appns.Namespace, shareVersion uint8, size uint32, r io.Reader) error {
+	if !slices.Contains(appconsts.SupportedShareVersions, shareVersion) {
+		return fmt.Errorf("unsupported share version: %d", shareVersion)
+	}
+	if size == 0 {
+		return errors.New("blob data can not be empty")
+	}
+
+	b, err := NewBuilder(ns, shareVersion, true)
+	if err != nil {
+		return err
+	}
+	if err := b.WriteSequenceLen(size); err != nil {
+		return err
+	}
+
+	remaining := size
+	for remaining > 0 {
+		n := uint32(b.AvailableBytes())
+		if n > remaining {
+			n = remaining
+		}
+		if _, err := io.ReadFull(r, ssw.buf[:n]); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return fmt.Errorf("reading blob data: %w", err)
+		}
+		b.AddData(ssw.buf[:n])
+		remaining -= n
+		if remaining == 0 {
+			b.ZeroPadIfNecessary()
+		}
+
+		share, err := b.Build()
+		if err != nil {
+			return err
+		}
+		if err := ssw.writeShare(share); err != nil {
+			return err
+		}
+
+		b, err = NewBuilder(ns, shareVersion, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	ssw.lastNamespace, ssw.lastShareVersion = ns, shareVersion
+	return nil
+}
+
+// WriteNamespacePaddingShares writes padding shares with the namespace of the
+// last written share. This function assumes that at least one share has
+// already been written.
+func (ssw *SparseShareWriter) WriteNamespacePaddingShares(count int) error {
+	if count < 0 {
+		return errors.New("cannot write negative namespaced shares")
+	}
+	if count == 0 {
+		return nil
+	}
+	if ssw.count == 0 {
+		return errors.New("cannot write namespace padding shares on an empty SparseShareWriter")
+	}
+	padding, err := NamespacePaddingShare(ssw.lastNamespace, ssw.lastShareVersion)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		if err := ssw.writeShare(&padding); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the number of shares written so far.
+func (ssw *SparseShareWriter) Count() int {
+	return ssw.count
+}
+
+func (ssw *SparseShareWriter) writeShare(share *Share) error {
+	if err := writeShare(ssw.w, share); err != nil {
+		return err
+	}
+	ssw.count++
+	return nil
+}
+
+// BlobHeader describes a blob read by a SparseShareReader.
+type BlobHeader struct {
+	Namespace    appns.Namespace
+	ShareVersion uint8
+	// Size is the length in bytes of the blob data.
+	Size uint32
+	// ShareIndex is the index of the first share of the blob in the stream of
+	// shares.
+	ShareIndex int
+}
+
+// SparseShareReader is the streaming counterpart of ParseBlobs. It reads
+// sparse shares one at a time from an underlying io.Reader and provides access
+// to the data of each blob as an io.Reader, so that only a single share is
+// held in memory. Padding shares are skipped.
+//
+// Next advances to the next blob and returns its header, after which the blob
+// data can be read by calling Read until it returns io.EOF. The data of the
+// blobs is identical to the data of the blobs returned by ParseBlobs for the
+// same shares.
+type SparseShareReader struct {
+	shares *ShareReader
+	// pending is a sequence start share read while reading the data of the
+	// previous blob.
+	pending *Share
+	// started is whether a sequence start share has been read.
+	started bool
+	// remaining is the number of bytes of the current blob that haven't been
+	// read yet.
+	remaining uint32
+	// data is the unread data of the current share of the current blob.
+	data []byte
+}
+
+// NewSparseShareReader returns a SparseShareReader reading shares from r.
+func NewSparseShareReader(r io.Reader) *SparseShareReader {
+	return &SparseShareReader{shares: NewShareReader(r)}
+}
+
+// Next advances to the next blob, skipping the unread data of the current
+// blob. It returns io.EOF when there are no more blobs.
+func (ssr *SparseShareReader) Next() (*BlobHeader, error) {
+	for {
+		share, index, err := ssr.nextShare()
+		if err != nil {
+			return nil, err
+		}
+		isStart, err := share.IsSequenceStart()
+		if err != nil {
+			return nil, err
+		}
+		if !isStart {
+			if !ssr.started {
+				return nil, fmt.Errorf("continuation share %v without a sequence start share", share)
+			}
+			// the remaining data of the previous blob is skipped
+			continue
+		}
+
+		header, err := ssr.start(share, index)
+		if err != nil {
+			return nil, err
+		}
+		return header, nil
+	}
+}
+
+// Read reads the data of the current blob. It returns io.EOF at the end of
+// the data of the blob.
+func (ssr *SparseShareReader) Read(p []byte) (int, error) {
+	if ssr.remaining == 0 {
+		return 0, io.EOF
+	}
+	if ssr.pending != nil {
+		return 0, ssr.errMissingData()
+	}
+	if len(ssr.data) == 0 {
+		share, _, err := ssr.nextShare()
+		if errors.Is(err, io.EOF) {
+			return 0, ssr.errMissingData()
+		}
+		if err != nil {
+			return 0, err
+		}
+		isStart, err := share.IsSequenceStart()
+		if err != nil {
+			return 0, err
+		}
+		if isStart {
+			ssr.pending = share
+			return 0, ssr.errMissingData()
+		}
+		if ssr.data, err = share.RawData(); err != nil {
+			return 0, err
+		}
+	}
+
+	data := ssr.data
+	if uint32(len(data)) > ssr.remaining {
+		data = data[:ssr.remaining]
+	}
+	n := copy(p, data)
+	ssr.data = ssr.data[n:]
+	ssr.remaining -= uint32(n)
+	return n, nil
+}
+
+// ReadBlob advances to the next blob and reads all of its data. It returns
+// io.EOF when there are no more blobs.
+func (ssr *SparseShareReader) ReadBlob() (*blob.Blob, error) {
+	header, err := ssr.Next()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, header.Size)
+	if _, err := io.ReadFull(ssr, data); err != nil {
+		return nil, err
+	}
+	return blob.New(header.Namespace, data, header.ShareVersion), nil
+}
+
+func (ssr *SparseShareReader) errMissingData() error {
+	return fmt.Errorf("blob is missing %d bytes: %w", ssr.remaining, io.ErrUnexpectedEOF)
+}
+
+// start makes the sequence start share the first share of the current blob.
+func (ssr *SparseShareReader) start(share *Share, index int) (*BlobHeader, error) {
+	sequenceLen, err := share.SequenceLen()
+	if err != nil {
+		return nil, err
+	}
+	version, err := share.Version()
+	if err != nil {
+		return nil, err
+	}
+	ns, err := share.Namespace()
+	if err != nil {
+		return nil, err
+	}
+	data, err := share.RawData()
+	if err != nil {
+		return nil, err
+	}
+
+	ssr.started = true
+	ssr.remaining = sequenceLen
+	ssr.data = data
+	return &BlobHeader{
+		Namespace:    ns,
+		ShareVersion: version,
+		Size:         sequenceLen,
+		ShareIndex:   index,
+	}, nil
+}
+
+// nextShare returns the next share that isn't padding along with its index.
+func (ssr *SparseShareReader) nextShare() (*Share, int, error) {
+	if ssr.pending != nil {
+		share := ssr.pending
+		ssr.pending = nil
+		return share, ssr.shares.Count() - 1, nil
+	}
+	for {
+		share, err := ssr.shares.ReadShare()
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := share.DoesSupportVersions(appconsts.SupportedShareVersions); err != nil {
+			return nil, 0, err
+		}
+		isPadding, err := share.IsPadding()
+		if err != nil {
+			return nil, 0, err
+		}
+		if !isPadding {
+			return &share, ssr.shares.Count() - 1, nil
+		}
+	}
+}
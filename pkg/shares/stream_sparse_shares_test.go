@@ -0,0 +1,136 @@
+package shares
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSparseShareWriter(t *testing.T) {
+	type test struct {
+		name      string
+		blobCount int
+		blobSize  int
+		padding   int
+	}
+	tests := []test{
+		{"single small blob", 1, 10, 0},
+		{"single exact size blob", 1, appconsts.FirstSparseShareContentSize, 0},
+		{"many big blobs", 10, appconsts.ContinuationSparseShareContentSize * 20, 0},
+		{"many blobs with namespace padding", 10, appconsts.ContinuationSparseShareContentSize * 4, 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			blobs := testfactory.GenerateRandomlySizedBlobs(tc.blobCount, tc.blobSize)
+
+			splitter := NewSparseShareSplitter()
+			buf := new(bytes.Buffer)
+			writer := NewSparseShareWriter(buf)
+			for _, b := range blobs {
+				require.NoError(t, splitter.Write(b))
+				require.NoError(t, writer.Write(b))
+				require.NoError(t, splitter.WriteNamespacePaddingShares(tc.padding))
+				require.NoError(t, writer.WriteNamespacePaddingShares(tc.padding))
+			}
+
+			expected := splitter.Export()
+			assert.Equal(t, len(expected), writer.Count())
+			assert.Equal(t, bytes.Join(ToBytes(expected), nil), buf.Bytes())
+		})
+	}
+
+	t.Run("blob data shorter than size", func(t *testing.T) {
+		b := testfactory.GenerateRandomBlob(1000)
+		writer := NewSparseShareWriter(io.Discard)
+		err := writer.WriteFrom(b.Namespace(), appconsts.ShareVersionZero, 1001, bytes.NewReader(b.Data))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+	t.Run("namespace padding on an empty writer", func(t *testing.T) {
+		writer := NewSparseShareWriter(io.Discard)
+		assert.Error(t, writer.WriteNamespacePaddingShares(1))
+	})
+}
+
+func TestSparseShareReader(t *testing.T) {
+	blobs := testfactory.GenerateRandomlySizedBlobs(10, appconsts.ContinuationSparseShareContentSize*10)
+	splitter := NewSparseShareSplitter()
+	for _, b := range blobs {
+		require.NoError(t, splitter.Write(b))
+		require.NoError(t, splitter.WriteNamespacePaddingShares(2))
+	}
+	shares := append(ReservedPaddingShares(3), splitter.Export()...)
+	shares = append(shares, TailPaddingShares(5)...)
+
+	expected, err := ParseBlobs(shares)
+	require.NoError(t, err)
+
+	t.Run("read blobs", func(t *testing.T) {
+		reader := NewSparseShareReader(bytes.NewReader(bytes.Join(ToBytes(shares), nil)))
+		got := make([]*blob.Blob, 0, len(expected))
+		shareIndex := len(ReservedPaddingShares(3))
+		for {
+			header, err := reader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			assert.Equal(t, shareIndex, header.ShareIndex)
+			shareIndex += SparseSharesNeeded(header.Size) + 2
+
+			data, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			require.Len(t, data, int(header.Size))
+			got = append(got, blob.New(header.Namespace, data, header.ShareVersion))
+		}
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("skip unread data", func(t *testing.T) {
+		reader := NewSparseShareReader(bytes.NewReader(bytes.Join(ToBytes(shares), nil)))
+		for i := range expected {
+			header, err := reader.Next()
+			require.NoError(t, err)
+			assert.Equal(t, expected[i].Namespace(), header.Namespace, fmt.Sprintf("blob %d", i))
+			// read only part of the data
+			_, err = reader.Read(make([]byte, 10))
+			require.NoError(t, err)
+		}
+		_, err := reader.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("read blob", func(t *testing.T) {
+		reader := NewSparseShareReader(bytes.NewReader(bytes.Join(ToBytes(shares), nil)))
+		for i := range expected {
+			got, err := reader.ReadBlob()
+			require.NoError(t, err)
+			assert.Equal(t, expected[i], got)
+		}
+		_, err := reader.ReadBlob()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("truncated blob", func(t *testing.T) {
+		blobShares, err := SplitBlobs(testfactory.GenerateRandomBlob(appconsts.ContinuationSparseShareContentSize * 3))
+		require.NoError(t, err)
+		reader := NewSparseShareReader(bytes.NewReader(bytes.Join(ToBytes(blobShares[:2]), nil)))
+		_, err = reader.ReadBlob()
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+
+	t.Run("continuation share without a sequence start share", func(t *testing.T) {
+		blobShares, err := SplitBlobs(testfactory.GenerateRandomBlob(appconsts.ContinuationSparseShareContentSize * 3))
+		require.NoError(t, err)
+		reader := NewSparseShareReader(bytes.NewReader(bytes.Join(ToBytes(blobShares[1:]), nil)))
+		_, err = reader.Next()
+		assert.Error(t, err)
+	})
+}
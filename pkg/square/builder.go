@@ -33,6 +33,12 @@ type Builder struct {
 	done                 bool
 	subtreeRootThreshold int
 	appVersion           uint64
+	// stats describes the layout of the last exported square.
+	stats Stats
+	// droppedTxs and droppedPfbs count the transactions that could not be
+	// appended for lack of space.
+	droppedTxs  int
+	droppedPfbs int
 }
 
 func NewBuilder(maxSquareSize int, appVersion uint64, txs ...[]byte) (*Builder, error) {
@@ -83,6 +89,7 @@ func (b *Builder) AppendTx(tx []byte) bool {
 		return true
 	}
 	b.TxCounter.Revert()
+	b.droppedTxs++
 	return false
 }
 
@@ -113,6 +120,7 @@ func (b *Builder) AppendBlobTx(blobTx blob.BlobTx) bool {
 		return true
 	}
 	b.PfbCounter.Revert()
+	b.droppedPfbs++
 	return false
 }
 
@@ -120,6 +128,13 @@ func (b *Builder) AppendBlobTx(blobTx blob.BlobTx) bool {
 func (b *Builder) Export() (Square, error) {
 	// if there are no transactions, return an empty square
 	if b.IsEmpty() {
+		b.stats = Stats{
+			SquareSize:        1,
+			DroppedTxs:        b.droppedTxs,
+			DroppedPFBs:       b.droppedPfbs,
+			TailPaddingShares: 1,
+			Namespaces:        make([]string, 0),
+		}
 		return EmptySquare(), nil
 	}
 
@@ -147,6 +162,7 @@ func (b *Builder) Export() (Square, error) {
 	nonReservedStart := b.TxCounter.Size() + b.PfbCounter.Size()
 	cursor := nonReservedStart
 	endOfLastBlob := nonReservedStart
+	namespacePadding := 0
 	blobWriter := shares.NewSparseShareSplitter()
 	for i, element := range b.Blobs {
 		// NextShareIndex returned where the next blob should start so as to comply with the share commitment rules
@@ -170,6 +186,7 @@ func (b *Builder) Export() (Square, error) {
 			if err := blobWriter.WriteNamespacePaddingShares(padding); err != nil {
 				return nil, fmt.Errorf("writing padding into sparse shares: %w", err)
 			}
+			namespacePadding += padding
 		}
 		// Finally write the blob itself
 		if err := blobWriter.Write(element.Blob); err != nil {
@@ -205,6 +222,7 @@ func (b *Builder) Export() (Square, error) {
 	}
 
 	b.done = true
+	b.stats = b.newStats(ss, txWriter.Count(), pfbWriter.Count(), nonReservedStart, namespacePadding)
 
 	return square, nil
 }
@@ -0,0 +1,212 @@
+package square
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+)
+
+// PaddingKind is the kind of padding of a padding share.
+type PaddingKind string
+
+const (
+	// NoPadding is the padding kind of shares that aren't padding.
+	NoPadding PaddingKind = ""
+	// NamespacePadding shares follow a blob so that the next blob starts at
+	// an index that complies with the share commitment rules.
+	NamespacePadding PaddingKind = "namespace"
+	// ReservedPadding shares follow the reserved namespaces so that the first
+	// blob starts at an index that complies with the share commitment rules.
+	ReservedPadding PaddingKind = "reserved"
+	// TailPadding shares fill the square after the last blob.
+	TailPadding PaddingKind = "tail"
+)
+
+// ShareInfo describes a share of a data square.
+type ShareInfo struct {
+	Index int `json:"index"`
+	// Namespace is the hex encoded namespace of the share.
+	Namespace     string `json:"namespace"`
+	ShareVersion  uint8  `json:"share_version"`
+	SequenceStart bool   `json:"sequence_start"`
+	// SequenceLen is the length in bytes of the sequence starting in this
+	// share, zero for continuation shares.
+	SequenceLen uint32 `json:"sequence_len"`
+	// ReservedBytes is the index of the byte at which the first unit starting
+	// in this share starts. It is only set for compact shares.
+	ReservedBytes *uint32     `json:"reserved_bytes,omitempty"`
+	Padding       PaddingKind `json:"padding,omitempty"`
+	// TxIndexes are the indexes in the block of the transactions with data in
+	// this share, which is the PFB that paid for the blob of a blob share.
+	TxIndexes []int `json:"tx_indexes,omitempty"`
+	// BlobIndex is the index of the blob in its PFB for blob shares.
+	BlobIndex *int `json:"blob_index,omitempty"`
+}
+
+// BlobViolation describes a blob that violates the non-interactive default
+// rules of the share commitment, see inclusion.NextShareIndex.
+type BlobViolation struct {
+	// ShareIndex is the index of the first share of the blob.
+	ShareIndex int    `json:"share_index"`
+	Namespace  string `json:"namespace"`
+	ShareLen   int    `json:"share_len"`
+	// ExpectedIndex is the index the blob should start at.
+	ExpectedIndex int    `json:"expected_index"`
+	Reason        string `json:"reason"`
+}
+
+// Inspect constructs the square of the block from its transactions, the same
+// way Construct does, and describes each of its shares along with the
+// transaction and blob it belongs to. Transactions are referred to by their
+// index in txs, which is their index in the block. It also returns the blobs
+// that violate the non-interactive default rules.
+func Inspect(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, []ShareInfo, []BlobViolation, error) {
+	square, err := Construct(txs, appVersion, maxSquareSize)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	infos, violations, err := InspectShares(square, appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// the builder is only used to locate the transactions and blobs in the
+	// square: Construct succeeded so it holds every transaction of the block
+	// in the block order.
+	builder, err := NewBuilder(maxSquareSize, appVersion, txs...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for txIndex, tx := range txs {
+		txRange, err := builder.FindTxShareRange(txIndex)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for i := txRange.Start; i < txRange.End && i < len(infos); i++ {
+			infos[i].TxIndexes = append(infos[i].TxIndexes, txIndex)
+		}
+
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if !isBlobTx {
+			continue
+		}
+		for blobIndex := range blobTx.Blobs {
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			shareLen, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			blobIndex := blobIndex
+			for i := start; i < start+shareLen; i++ {
+				infos[i].TxIndexes = []int{txIndex}
+				infos[i].BlobIndex = &blobIndex
+			}
+		}
+	}
+
+	return square, infos, violations, nil
+}
+
+// InspectShares describes each share of the square and returns the blobs that
+// violate the non-interactive default rules: a blob must start at an index
+// that is a multiple of its subtree width and must not be preceded by more
+// padding than required to do so.
+func InspectShares(square Square, subtreeRootThreshold int) ([]ShareInfo, []BlobViolation, error) {
+	infos := make([]ShareInfo, len(square))
+	violations := make([]BlobViolation, 0)
+	// the index following the last blob, or -1 before the first blob
+	endOfLastBlob := -1
+	for i := range square {
+		info, err := inspectShare(i, &square[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("share %d: %w", i, err)
+		}
+		infos[i] = info
+
+		isCompact, err := square[i].IsCompactShare()
+		if err != nil {
+			return nil, nil, err
+		}
+		if isCompact || info.Padding != NoPadding || !info.SequenceStart {
+			continue
+		}
+
+		shareLen := shares.SparseSharesNeeded(info.SequenceLen)
+		if aligned := inclusion.NextShareIndex(i, shareLen, subtreeRootThreshold); aligned != i {
+			violations = append(violations, BlobViolation{
+				ShareIndex:    i,
+				Namespace:     info.Namespace,
+				ShareLen:      shareLen,
+				ExpectedIndex: aligned,
+				Reason:        fmt.Sprintf("start is not a multiple of the subtree width %d", inclusion.SubTreeWidth(shareLen, subtreeRootThreshold)),
+			})
+		} else if endOfLastBlob != -1 {
+			if expected := inclusion.NextShareIndex(endOfLastBlob, shareLen, subtreeRootThreshold); expected != i {
+				violations = append(violations, BlobViolation{
+					ShareIndex:    i,
+					Namespace:     info.Namespace,
+					ShareLen:      shareLen,
+					ExpectedIndex: expected,
+					Reason:        fmt.Sprintf("preceded by %d padding shares instead of %d", i-endOfLastBlob, expected-endOfLastBlob),
+				})
+			}
+		}
+		endOfLastBlob = i + shareLen
+	}
+	return infos, violations, nil
+}
+
+func inspectShare(index int, share *shares.Share) (ShareInfo, error) {
+	ns, err := share.Namespace()
+	if err != nil {
+		return ShareInfo{}, err
+	}
+	infoByte, err := share.InfoByte()
+	if err != nil {
+		return ShareInfo{}, err
+	}
+	sequenceLen, err := share.SequenceLen()
+	if err != nil {
+		return ShareInfo{}, err
+	}
+	info := ShareInfo{
+		Index:         index,
+		Namespace:     hex.EncodeToString(ns.Bytes()),
+		ShareVersion:  infoByte.Version(),
+		SequenceStart: infoByte.IsSequenceStart(),
+		SequenceLen:   sequenceLen,
+	}
+
+	switch {
+	case ns.IsTailPadding():
+		info.Padding = TailPadding
+	case ns.IsPrimaryReservedPadding():
+		info.Padding = ReservedPadding
+	case info.SequenceStart && sequenceLen == 0:
+		info.Padding = NamespacePadding
+	}
+
+	isCompact, err := share.IsCompactShare()
+	if err != nil {
+		return ShareInfo{}, err
+	}
+	if isCompact {
+		start := appconsts.NamespaceSize + appconsts.ShareInfoBytes
+		if info.SequenceStart {
+			start += appconsts.SequenceLenBytes
+		}
+		reservedBytes, err := shares.ParseReservedBytes(share.ToBytes()[start : start+appconsts.CompactShareReservedBytes])
+		if err != nil {
+			return ShareInfo{}, err
+		}
+		info.ReservedBytes = &reservedBytes
+	}
+	return info, nil
+}
@@ -0,0 +1,150 @@
+package square_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestInspect(t *testing.T) {
+	rand := tmrand.NewRand()
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	sendTxs := blobfactory.GenerateManyRawSendTxs(signer, 3)
+	pfbTxs := blobfactory.RandBlobTxs(signer, rand, 2, 3, 2000)
+	txs := coretypes.Txs(append(sendTxs, pfbTxs...)).ToSliceOfBytes()
+
+	dataSquare, infos, violations, err := square.Inspect(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+	require.Len(t, infos, len(dataSquare))
+
+	// every transaction and blob is attributed to shares
+	blobShares := make(map[[2]int]int)
+	seenTxs := make(map[int]bool)
+	for i, info := range infos {
+		require.Equal(t, i, info.Index)
+		for _, txIndex := range info.TxIndexes {
+			seenTxs[txIndex] = true
+		}
+		if info.BlobIndex != nil {
+			require.Len(t, info.TxIndexes, 1)
+			require.Equal(t, square.NoPadding, info.Padding)
+			require.Nil(t, info.ReservedBytes)
+			blobShares[[2]int{info.TxIndexes[0], *info.BlobIndex}]++
+			continue
+		}
+		if info.Padding == square.NoPadding {
+			// the remaining shares are compact shares
+			require.NotNil(t, info.ReservedBytes)
+		}
+	}
+	require.Len(t, seenTxs, len(txs))
+	for txIndex := range txs {
+		txRange, err := square.TxShareRange(txs, txIndex, appconsts.LatestVersion)
+		require.NoError(t, err)
+		for i := txRange.Start; i < txRange.End; i++ {
+			require.Contains(t, infos[i].TxIndexes, txIndex)
+		}
+	}
+	require.Len(t, blobShares, 2*3)
+	for key, count := range blobShares {
+		shareRange, err := square.BlobShareRange(txs, key[0], key[1], appconsts.LatestVersion)
+		require.NoError(t, err)
+		require.Equal(t, shareRange.End-shareRange.Start, count)
+	}
+	require.Equal(t, square.TailPadding, infos[len(infos)-1].Padding)
+}
+
+func TestInspectInvalidBlock(t *testing.T) {
+	rand := tmrand.NewRand()
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	sendTxs := blobfactory.GenerateManyRawSendTxs(signer, 3)
+	pfbTxs := blobfactory.RandBlobTxs(signer, rand, 2, 3, 2000)
+
+	t.Run("normal tx after a blob tx", func(t *testing.T) {
+		txs := coretypes.Txs(append(pfbTxs, sendTxs...)).ToSliceOfBytes()
+		_, _, _, err := square.Inspect(txs, appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+		require.Error(t, err)
+	})
+
+	t.Run("txs exceeding the max square size", func(t *testing.T) {
+		txs := coretypes.Txs(append(sendTxs, pfbTxs...)).ToSliceOfBytes()
+		_, _, _, err := square.Inspect(txs, appconsts.LatestVersion, 2)
+		require.Error(t, err)
+	})
+}
+
+func TestInspectSharesViolations(t *testing.T) {
+	rand := tmrand.NewRand()
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	pfbTxs := blobfactory.RandBlobTxs(signer, rand, 1, 2, 2000)
+	dataSquare, err := square.Construct(coretypes.Txs(pfbTxs).ToSliceOfBytes(), appconsts.LatestVersion, appconsts.DefaultSquareSizeUpperBound)
+	require.NoError(t, err)
+
+	infos, violations, err := square.InspectShares(dataSquare, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	require.Empty(t, violations)
+	blobStarts := make([]int, 0, 2)
+	for _, info := range infos {
+		if info.Padding == square.NoPadding && info.ReservedBytes == nil && info.SequenceStart {
+			blobStarts = append(blobStarts, info.Index)
+		}
+	}
+	require.Len(t, blobStarts, 2)
+	shareLen := shares.SparseSharesNeeded(infos[blobStarts[0]].SequenceLen)
+
+	t.Run("excess padding", func(t *testing.T) {
+		// the blobs have a subtree width of 1 so the second blob must directly
+		// follow the first one.
+		require.Equal(t, blobStarts[0]+shareLen, blobStarts[1])
+		ns, err := dataSquare[blobStarts[0]].Namespace()
+		require.NoError(t, err)
+		padding, err := shares.NamespacePaddingShare(ns, appconsts.ShareVersionZero)
+		require.NoError(t, err)
+		padded := insertShares(dataSquare, blobStarts[1], padding)
+
+		_, violations, err := square.InspectShares(padded, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		require.Len(t, violations, 1)
+		require.Equal(t, blobStarts[1]+1, violations[0].ShareIndex)
+		require.Equal(t, blobStarts[1], violations[0].ExpectedIndex)
+	})
+
+	t.Run("misaligned blob", func(t *testing.T) {
+		// with a subtree root threshold of 1 the subtree width of the first
+		// blob is greater than 1, so it is misaligned at one of two
+		// consecutive indexes.
+		subtreeRootThreshold := 1
+		require.Greater(t, inclusion.SubTreeWidth(shareLen, subtreeRootThreshold), 1)
+		shifted := dataSquare
+		if inclusion.NextShareIndex(blobStarts[0], shareLen, subtreeRootThreshold) == blobStarts[0] {
+			shifted = insertShares(dataSquare, blobStarts[0], shares.ReservedPaddingShare())
+		}
+		start := blobStarts[0] + len(shifted) - len(dataSquare)
+
+		_, violations, err := square.InspectShares(shifted, subtreeRootThreshold)
+		require.NoError(t, err)
+		require.NotEmpty(t, violations)
+		require.Equal(t, start, violations[0].ShareIndex)
+		require.Equal(t, inclusion.NextShareIndex(start, shareLen, subtreeRootThreshold), violations[0].ExpectedIndex)
+	})
+}
+
+// insertShares inserts the shares at index, dropping as many shares from the
+// end of the square so that its size is unchanged.
+func insertShares(s square.Square, index int, inserted ...shares.Share) square.Square {
+	result := append(square.Square{}, s[:index]...)
+	result = append(result, inserted...)
+	return append(result, s[index:len(s)-len(inserted)]...)
+}
@@ -0,0 +1,386 @@
+package square
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/tendermint/tendermint/pkg/consts"
+	coretypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// OrderPreservingStrategyName is the name of the packing strategy that
+	// appends transactions in the given order. It is the default.
+	OrderPreservingStrategyName = "order"
+	// FeePerShareStrategyName is the name of the packing strategy that appends
+	// blob transactions by decreasing fee per share.
+	FeePerShareStrategyName = "fee-per-share"
+	// KnapsackStrategyName is the name of the packing strategy that selects
+	// the blob transactions maximizing the total fee of the square.
+	KnapsackStrategyName = "knapsack"
+)
+
+// knapsackResolution is the number of units the free space of the square is
+// divided into when selecting blob transactions with the knapsack strategy.
+// The weights of the transactions are rounded up to a unit so the higher the
+// resolution, the closer to optimal and the slower the selection is.
+const knapsackResolution = 1024
+
+// PackingStrategy decides which transactions are appended to a square and in
+// which order. Strategies must be deterministic.
+type PackingStrategy interface {
+	// Pack appends the transactions to the builder and returns the ones that
+	// were appended, with normal transactions ordered before blob
+	// transactions.
+	Pack(builder *Builder, txs [][]byte) [][]byte
+}
+
+// FeeFunc returns the fee paid by a transaction. For blob transactions, it is
+// passed the transaction without its blobs. Transactions whose fee can't be
+// determined should be reported as paying no fee.
+type FeeFunc func(tx []byte) uint64
+
+// SignersFunc returns the accounts whose sequence is incremented by a
+// transaction. For blob transactions, it is passed the transaction without its
+// blobs. Transactions sharing a signer must keep their relative order in the
+// square for their sequences to be valid, so strategies only reorder
+// transactions of different signers. Transactions whose signers can't be
+// determined should be reported as having no signer.
+type SignersFunc func(tx []byte) []string
+
+// NewPackingStrategy returns the packing strategy with the provided name. An
+// empty name returns the default order preserving strategy.
+func NewPackingStrategy(name string, fee FeeFunc, signers SignersFunc) (PackingStrategy, error) {
+	switch name {
+	case "", OrderPreservingStrategyName:
+		return OrderPreservingStrategy{}, nil
+	case FeePerShareStrategyName:
+		return FeePerShareStrategy{Fee: fee, Signers: signers}, nil
+	case KnapsackStrategyName:
+		return KnapsackStrategy{Fee: fee, Signers: signers}, nil
+	default:
+		return nil, fmt.Errorf("unknown packing strategy %q, must be one of %s, %s or %s",
+			name, OrderPreservingStrategyName, FeePerShareStrategyName, KnapsackStrategyName)
+	}
+}
+
+// OrderPreservingStrategy appends the transactions in the given, prioritized,
+// order and drops the ones that don't fit. A large blob early in the order can
+// therefore exclude many smaller ones that follow it.
+type OrderPreservingStrategy struct{}
+
+var _ PackingStrategy = OrderPreservingStrategy{}
+
+func (OrderPreservingStrategy) Pack(builder *Builder, txs [][]byte) [][]byte {
+	normalTxs := make([][]byte, 0, len(txs))
+	blobTxs := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			if builder.AppendBlobTx(blobTx) {
+				blobTxs = append(blobTxs, tx)
+			}
+		} else {
+			if builder.AppendTx(tx) {
+				normalTxs = append(normalTxs, tx)
+			}
+		}
+	}
+	return append(normalTxs, blobTxs...)
+}
+
+// FeePerShareStrategy appends the normal transactions in the given order and
+// then the blob transactions by decreasing fee per share, where the shares of
+// a blob transaction include the worst case padding of its blobs. Blob
+// transactions of the same signer keep their relative order: the next one is
+// only considered once the previous one was appended, and the ones following a
+// transaction that doesn't fit are dropped. Blob transactions paying the same
+// fee per share keep their relative order. If Signers is nil, all the blob
+// transactions are treated as sharing a signer.
+type FeePerShareStrategy struct {
+	Fee     FeeFunc
+	Signers SignersFunc
+}
+
+var _ PackingStrategy = FeePerShareStrategy{}
+
+func (s FeePerShareStrategy) Pack(builder *Builder, txs [][]byte) [][]byte {
+	normalTxs, candidates := appendNormalTxs(builder, txs, s.Fee, s.Signers)
+	return append(normalTxs, appendCandidates(builder, orderByFeePerShare(candidates))...)
+}
+
+// KnapsackStrategy appends the normal transactions in the given order and
+// then selects the blob transactions maximizing the total fee paid without
+// exceeding the remaining space of the square, where the shares of a blob
+// transaction include the worst case padding of its blobs. As the transactions
+// of a signer must keep their relative order, only a prefix of the blob
+// transactions of each signer can be selected. The selection is approximated
+// by dividing the remaining space in a fixed number of units. Blob
+// transactions are appended by decreasing fee per share, followed by any
+// unselected transaction that still fits. If Signers is nil, all the blob
+// transactions are treated as sharing a signer.
+type KnapsackStrategy struct {
+	Fee     FeeFunc
+	Signers SignersFunc
+}
+
+var _ PackingStrategy = KnapsackStrategy{}
+
+func (s KnapsackStrategy) Pack(builder *Builder, txs [][]byte) [][]byte {
+	normalTxs, candidates := appendNormalTxs(builder, txs, s.Fee, s.Signers)
+
+	capacity := builder.maxCapacity - builder.currentSize
+	unit := (capacity + knapsackResolution - 1) / knapsackResolution
+	if unit == 0 {
+		return normalTxs
+	}
+	units := capacity / unit
+
+	// each group of transactions sharing signers is an item whose options are
+	// the prefixes of the group. best[w] is the highest fee of a selection
+	// weighing at most w units and chosen[g][w] is the length of the prefix of
+	// group g that is part of it.
+	groups := groupCandidates(candidates)
+	best := make([]uint64, units+1)
+	chosen := make([][]int, len(groups))
+	for g, group := range groups {
+		chosen[g] = make([]int, units+1)
+		weights := make([]int, len(group))
+		fees := make([]uint64, len(group))
+		weight, fee := 0, uint64(0)
+		for i, c := range group {
+			weight += (c.shares + unit - 1) / unit
+			fee += c.fee
+			weights[i], fees[i] = weight, fee
+		}
+		for w := units; w >= 0; w-- {
+			for i := range group {
+				if weights[i] > w {
+					break
+				}
+				if fee := best[w-weights[i]] + fees[i]; fee > best[w] {
+					best[w] = fee
+					chosen[g][w] = i + 1
+				}
+			}
+		}
+	}
+	selected := make([]packingCandidate, 0, len(candidates))
+	unselected := make([]packingCandidate, 0, len(candidates))
+	prefixes := make([]int, len(groups))
+	for g, w := len(groups)-1, units; g >= 0; g-- {
+		prefixes[g] = chosen[g][w]
+		for _, c := range groups[g][:prefixes[g]] {
+			w -= (c.shares + unit - 1) / unit
+		}
+	}
+	for g, group := range groups {
+		selected = append(selected, group[:prefixes[g]]...)
+		unselected = append(unselected, group[prefixes[g]:]...)
+	}
+
+	// append the selected transactions first and fill the remaining space
+	// with the ones that weren't selected. As only prefixes are selected, this
+	// keeps the order of the transactions of each group.
+	ordered := append(orderByFeePerShare(selected), orderByFeePerShare(unselected)...)
+	return append(normalTxs, appendCandidates(builder, ordered)...)
+}
+
+// packingCandidate is a blob transaction considered by a packing strategy.
+type packingCandidate struct {
+	tx     []byte
+	blobTx blob.BlobTx
+	fee    uint64
+	// shares is the worst case number of shares used by the transaction and
+	// its blobs.
+	shares int
+	// index is the position of the transaction among the blob transactions.
+	index int
+	// group identifies the transactions sharing signers, directly or through
+	// other transactions.
+	group int
+}
+
+// appendNormalTxs appends the normal transactions to the builder and returns
+// the appended ones along with the blob transactions to pack, grouped by
+// signers.
+func appendNormalTxs(builder *Builder, txs [][]byte, fee FeeFunc, signers SignersFunc) ([][]byte, []packingCandidate) {
+	normalTxs := make([][]byte, 0, len(txs))
+	candidates := make([]packingCandidate, 0, len(txs))
+	groups := newSignerGroups()
+	for _, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if isBlobTx {
+			candidates = append(candidates, packingCandidate{
+				tx:     tx,
+				blobTx: blobTx,
+				fee:    fee(blobTx.Tx),
+				shares: worstCaseShares(blobTx, builder.subtreeRootThreshold, builder.appVersion),
+				index:  len(candidates),
+				group:  groups.add(len(candidates), txSigners(signers, blobTx.Tx)),
+			})
+			continue
+		}
+		if builder.AppendTx(tx) {
+			normalTxs = append(normalTxs, tx)
+		}
+	}
+	for i := range candidates {
+		candidates[i].group = groups.find(candidates[i].group)
+	}
+	return normalTxs, candidates
+}
+
+// appendCandidates appends the blob transactions to the builder in order and
+// returns the appended ones. Once a transaction doesn't fit, the following
+// transactions of its group are dropped as their sequences would be invalid.
+func appendCandidates(builder *Builder, candidates []packingCandidate) [][]byte {
+	blobTxs := make([][]byte, 0, len(candidates))
+	dropped := make(map[int]bool)
+	for _, c := range candidates {
+		if dropped[c.group] {
+			continue
+		}
+		if builder.AppendBlobTx(c.blobTx) {
+			blobTxs = append(blobTxs, c.tx)
+		} else {
+			dropped[c.group] = true
+		}
+	}
+	return blobTxs
+}
+
+// groupCandidates returns the candidates of each group, in order, with the
+// groups ordered by their first candidate.
+func groupCandidates(candidates []packingCandidate) [][]packingCandidate {
+	groups := make([][]packingCandidate, 0)
+	position := make(map[int]int)
+	for _, c := range candidates {
+		g, ok := position[c.group]
+		if !ok {
+			g = len(groups)
+			position[c.group] = g
+			groups = append(groups, nil)
+		}
+		groups[g] = append(groups[g], c)
+	}
+	return groups
+}
+
+// orderByFeePerShare orders the candidates by decreasing fee per share while
+// keeping the order of the candidates of each group: at each step, the next
+// candidate of the group paying the most per share is picked. Candidates
+// paying the same fee per share keep their relative order.
+func orderByFeePerShare(candidates []packingCandidate) []packingCandidate {
+	groups := groupCandidates(candidates)
+	ordered := make([]packingCandidate, 0, len(candidates))
+	for len(ordered) < len(candidates) {
+		pick := -1
+		for g, group := range groups {
+			if len(group) == 0 {
+				continue
+			}
+			if pick == -1 || paysMorePerShare(group[0], groups[pick][0]) {
+				pick = g
+			}
+		}
+		ordered = append(ordered, groups[pick][0])
+		groups[pick] = groups[pick][1:]
+	}
+	return ordered
+}
+
+// paysMorePerShare returns true if a pays more per share than b, or the same
+// and comes first.
+func paysMorePerShare(a, b packingCandidate) bool {
+	// compare fee_a / shares_a > fee_b / shares_b without dividing
+	left := mulUint64(a.fee, uint64(b.shares))
+	right := mulUint64(b.fee, uint64(a.shares))
+	if cmp := left.cmp(right); cmp != 0 {
+		return cmp > 0
+	}
+	return a.index < b.index
+}
+
+// txSigners returns the signers of the transaction. Without a signers
+// function, the transactions all share a signer.
+func txSigners(signers SignersFunc, tx []byte) []string {
+	if signers == nil {
+		return []string{""}
+	}
+	return signers(tx)
+}
+
+// signerGroups is a union find of the transactions sharing signers.
+type signerGroups struct {
+	parent  []int
+	signers map[string]int
+}
+
+func newSignerGroups() *signerGroups {
+	return &signerGroups{signers: make(map[string]int)}
+}
+
+// add adds the transaction with the provided index, which must be the number
+// of transactions already added, and returns its group.
+func (g *signerGroups) add(index int, signers []string) int {
+	g.parent = append(g.parent, index)
+	for _, signer := range signers {
+		if other, ok := g.signers[signer]; ok {
+			g.parent[g.find(other)] = g.find(index)
+		}
+		g.signers[signer] = index
+	}
+	return index
+}
+
+func (g *signerGroups) find(index int) int {
+	for g.parent[index] != index {
+		g.parent[index] = g.parent[g.parent[index]]
+		index = g.parent[index]
+	}
+	return index
+}
+
+// worstCaseShares returns the number of shares reserved by the builder for
+// the blob transaction, including the worst case padding of its blobs, see
+// Builder.AppendBlobTx.
+func worstCaseShares(blobTx blob.BlobTx, subtreeRootThreshold int, appVersion uint64) int {
+	iw := &coretypes.IndexWrapper{
+		Tx:           blobTx.Tx,
+		TypeId:       consts.ProtoIndexWrapperTypeID,
+		ShareIndexes: worstCaseShareIndexes(len(blobTx.Blobs), appVersion),
+	}
+	count := (iw.Size() + appconsts.ContinuationCompactShareContentSize - 1) / appconsts.ContinuationCompactShareContentSize
+	for _, b := range blobTx.Blobs {
+		blobShares := shares.SparseSharesNeeded(uint32(len(b.Data)))
+		count += blobShares + inclusion.SubTreeWidth(blobShares, subtreeRootThreshold) - 1
+	}
+	return count
+}
+
+// uint128 is a 128 bit unsigned integer used to compare fees per share
+// without overflowing or losing precision.
+type uint128 struct {
+	hi, lo uint64
+}
+
+func mulUint64(x, y uint64) uint128 {
+	hi, lo := bits.Mul64(x, y)
+	return uint128{hi: hi, lo: lo}
+}
+
+func (u uint128) cmp(v uint128) int {
+	switch {
+	case u == v:
+		return 0
+	case u.hi < v.hi, u.hi == v.hi && u.lo < v.lo:
+		return -1
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,124 @@
+package square_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPackingStrategy(t *testing.T) {
+	for _, name := range []string{"", square.OrderPreservingStrategyName, square.FeePerShareStrategyName, square.KnapsackStrategyName} {
+		_, err := square.NewPackingStrategy(name, nil, nil)
+		assert.NoError(t, err, name)
+	}
+	_, err := square.NewPackingStrategy("random", nil, nil)
+	assert.Error(t, err)
+
+	strategy, err := square.NewPackingStrategy("", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, square.OrderPreservingStrategy{}, strategy)
+}
+
+func TestPackingStrategies(t *testing.T) {
+	fees := make(map[string]uint64)
+	signers := make(map[string]string)
+	// newBlobTx returns a blob tx paying the fee for a blob spanning the
+	// provided number of shares. The tx isn't signed as the builder doesn't
+	// decode it, each tx has its own signer.
+	newBlobTx := func(t *testing.T, id byte, fee uint64, shareCount int) []byte {
+		tx := bytes.Repeat([]byte{id}, 300)
+		fees[string(tx)] = fee
+		signers[string(tx)] = string(id)
+		namespace := ns.MustNewV0(bytes.Repeat([]byte{id}, ns.NamespaceVersionZeroIDSize))
+		b := blob.New(namespace, make([]byte, blobSizeForShares(shareCount)), appconsts.ShareVersionZero)
+		blobTx, err := blob.MarshalBlobTx(tx, b)
+		require.NoError(t, err)
+		return blobTx
+	}
+	fee := func(tx []byte) uint64 {
+		return fees[string(tx)]
+	}
+	signer := func(tx []byte) []string {
+		return []string{signers[string(tx)]}
+	}
+	// shareSigner makes the tx with the provided id signed by the signer of
+	// the tx with the other id.
+	shareSigner := func(id, otherID byte) {
+		signers[string(bytes.Repeat([]byte{id}, 300))] = signers[string(bytes.Repeat([]byte{otherID}, 300))]
+	}
+	normalTx := bytes.Repeat([]byte{0xff}, 100)
+
+	// a large low fee blob ahead of many small high fee blobs
+	large := newBlobTx(t, 1, 100, 50)
+	txs := [][]byte{normalTx, large}
+	small := make([][]byte, 0, 10)
+	for i := 0; i < 10; i++ {
+		tx := newBlobTx(t, byte(i+2), 100, 1)
+		small = append(small, tx)
+		txs = append(txs, tx)
+	}
+
+	_, orderedTxs, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, square.OrderPreservingStrategy{})
+	require.NoError(t, err)
+	assert.Equal(t, normalTx, orderedTxs[0])
+	assert.Equal(t, large, orderedTxs[1])
+	assert.Less(t, len(orderedTxs), len(txs))
+
+	for _, strategy := range []square.PackingStrategy{square.FeePerShareStrategy{Fee: fee, Signers: signer}, square.KnapsackStrategy{Fee: fee, Signers: signer}} {
+		dataSquare, orderedTxs, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, strategy)
+		require.NoError(t, err)
+		assert.Equal(t, append([][]byte{normalTx}, small...), orderedTxs)
+
+		// the square can be constructed from the ordered txs
+		constructed, err := square.Construct(orderedTxs, appconsts.LatestVersion, 8)
+		require.NoError(t, err)
+		assert.True(t, dataSquare.Equals(constructed))
+	}
+
+	// a small blob paying the most per share that excludes a large blob paying
+	// more in total
+	best := newBlobTx(t, 12, 40, 2)
+	large = newBlobTx(t, 13, 600, 63)
+	txs = [][]byte{large, best}
+
+	_, orderedTxs, err = square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, square.FeePerShareStrategy{Fee: fee, Signers: signer})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{best}, orderedTxs)
+
+	dataSquare, orderedTxs, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, square.KnapsackStrategy{Fee: fee, Signers: signer})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{large}, orderedTxs)
+	constructed, err := square.Construct(orderedTxs, appconsts.LatestVersion, 8)
+	require.NoError(t, err)
+	assert.True(t, dataSquare.Equals(constructed))
+
+	// the txs of a signer keep their order, so a large low fee blob can't be
+	// overtaken by a small high fee blob of the same signer
+	first := newBlobTx(t, 14, 10, 20)
+	second := newBlobTx(t, 15, 100, 1)
+	other := newBlobTx(t, 16, 50, 1)
+	shareSigner(15, 14)
+	txs = [][]byte{first, second, other}
+	for _, strategy := range []square.PackingStrategy{square.FeePerShareStrategy{Fee: fee, Signers: signer}, square.KnapsackStrategy{Fee: fee, Signers: signer}} {
+		_, orderedTxs, err = square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, strategy)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{other, first, second}, orderedTxs)
+	}
+
+	// if the first tx of a signer doesn't fit, the following ones are dropped
+	first = newBlobTx(t, 17, 10, 70)
+	second = newBlobTx(t, 18, 100, 1)
+	shareSigner(18, 17)
+	txs = [][]byte{first, second, other}
+	for _, strategy := range []square.PackingStrategy{square.FeePerShareStrategy{Fee: fee, Signers: signer}, square.KnapsackStrategy{Fee: fee, Signers: signer}} {
+		_, orderedTxs, err = square.BuildWithStrategy(txs, appconsts.LatestVersion, 8, strategy)
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{other}, orderedTxs)
+	}
+}
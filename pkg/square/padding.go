@@ -0,0 +1,251 @@
+package square
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	coretypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// MinimizePaddingStrategy packs the transactions with the wrapped strategy and
+// then reorders the appended blob transactions to reduce the padding between
+// blobs, see Builder.MinimizePadding.
+type MinimizePaddingStrategy struct {
+	Strategy PackingStrategy
+	// Signers returns the signers of the blob transactions, whose relative
+	// order is kept. If nil, the transactions all share a signer and can't be
+	// reordered.
+	Signers SignersFunc
+}
+
+var _ PackingStrategy = MinimizePaddingStrategy{}
+
+func (s MinimizePaddingStrategy) Pack(builder *Builder, txs [][]byte) [][]byte {
+	orderedTxs := s.Strategy.Pack(builder, txs)
+	blobTxStart := len(orderedTxs)
+	for blobTxStart > 0 {
+		if _, isBlobTx := blob.UnmarshalBlobTx(orderedTxs[blobTxStart-1]); !isBlobTx {
+			break
+		}
+		blobTxStart--
+	}
+	// the blob transactions can only be mapped to the PFBs of the builder if
+	// they are the only ones it contains
+	if len(orderedTxs)-blobTxStart != len(builder.Pfbs) {
+		return orderedTxs
+	}
+
+	order := builder.MinimizePadding(s.Signers)
+	reordered := make([][]byte, blobTxStart, len(orderedTxs))
+	copy(reordered, orderedTxs[:blobTxStart])
+	for _, pfbIndex := range order {
+		reordered = append(reordered, orderedTxs[blobTxStart+pfbIndex])
+	}
+	return reordered
+}
+
+// MinimizePadding reorders the PFBs of the builder to reduce the padding
+// needed by the blobs to comply with the non-interactive default rules, and
+// returns the new order as the previous indexes of the PFBs. Blobs are laid
+// out by namespace and then by the order of their PFB, so reordering the PFBs
+// reorders the blobs within a namespace without changing the way a square is
+// constructed from the transactions. The PFBs sharing a signer, as returned by
+// signers, keep their relative order for their sequences to remain valid. The
+// order is only changed if it strictly reduces the padding and the result is
+// deterministic. Note that the square size is derived from the worst case
+// padding of each blob, so it is not affected.
+func (b *Builder) MinimizePadding(signers SignersFunc) []int {
+	best := make([]int, len(b.Pfbs))
+	for i := range best {
+		best[i] = i
+	}
+	blobsByPfb := b.blobsByPfb()
+	groups := b.pfbGroups(signers)
+	bestEnd := b.blobsEnd(best, blobsByPfb)
+	for _, order := range [][]int{b.greedyPaddingOrder(blobsByPfb), b.widthOrder(blobsByPfb)} {
+		order = keepGroupOrder(order, groups)
+		if end := b.blobsEnd(order, blobsByPfb); end < bestEnd {
+			best, bestEnd = order, end
+		}
+	}
+	b.reorderPfbs(best, blobsByPfb)
+	return best
+}
+
+// pfbGroups returns the group of each PFB, PFBs sharing signers, directly or
+// through other PFBs, being in the same group.
+func (b *Builder) pfbGroups(signers SignersFunc) []int {
+	groups := newSignerGroups()
+	for i, pfb := range b.Pfbs {
+		groups.add(i, txSigners(signers, pfb.Tx))
+	}
+	pfbGroups := make([]int, len(b.Pfbs))
+	for i := range pfbGroups {
+		pfbGroups[i] = groups.find(i)
+	}
+	return pfbGroups
+}
+
+// keepGroupOrder returns the order with the PFBs of each group put back in
+// their current relative order. The positions taken by the PFBs of a group are
+// kept, so the groups remain interleaved as in the provided order.
+func keepGroupOrder(order []int, groups []int) []int {
+	byGroup := make(map[int][]int)
+	for i := range groups {
+		byGroup[groups[i]] = append(byGroup[groups[i]], i)
+	}
+	kept := make([]int, len(order))
+	for i, pfbIndex := range order {
+		group := groups[pfbIndex]
+		kept[i] = byGroup[group][0]
+		byGroup[group] = byGroup[group][1:]
+	}
+	return kept
+}
+
+// blobsByPfb returns the blob elements of each PFB ordered by blob index.
+func (b *Builder) blobsByPfb() [][]*Element {
+	blobsByPfb := make([][]*Element, len(b.Pfbs))
+	for _, element := range b.Blobs {
+		blobsByPfb[element.PfbIndex] = append(blobsByPfb[element.PfbIndex], element)
+	}
+	for _, elements := range blobsByPfb {
+		sort.Slice(elements, func(i, j int) bool {
+			return elements[i].BlobIndex < elements[j].BlobIndex
+		})
+	}
+	return blobsByPfb
+}
+
+// layout returns the blobs in the order they are written to the square when
+// the PFBs are in the provided order, see Export.
+func layout(order []int, blobsByPfb [][]*Element) []*Element {
+	elements := make([]*Element, 0, len(blobsByPfb))
+	for _, pfbIndex := range order {
+		elements = append(elements, blobsByPfb[pfbIndex]...)
+	}
+	sort.SliceStable(elements, func(i, j int) bool {
+		return bytes.Compare(elements[i].Blob.Namespace().Bytes(), elements[j].Blob.Namespace().Bytes()) < 0
+	})
+	return elements
+}
+
+// blobsEnd returns the index following the last blob of the square when the
+// PFBs are in the provided order.
+func (b *Builder) blobsEnd(order []int, blobsByPfb [][]*Element) int {
+	cursor := b.TxCounter.Size() + b.PfbCounter.Size()
+	for _, element := range layout(order, blobsByPfb) {
+		cursor = inclusion.NextShareIndex(cursor, element.NumShares, b.subtreeRootThreshold) + element.NumShares
+	}
+	return cursor
+}
+
+// greedyPaddingOrder lays out the blobs of each namespace by picking, at each
+// step, the blob that needs the least padding at the current index, preferring
+// wider subtrees and then the current order. The PFBs are then ordered by the
+// position of their first blob in this layout.
+func (b *Builder) greedyPaddingOrder(blobsByPfb [][]*Element) []int {
+	current := make([]int, len(b.Pfbs))
+	for i := range current {
+		current[i] = i
+	}
+	elements := layout(current, blobsByPfb)
+	position := make(map[*Element]int, len(elements))
+	cursor := b.TxCounter.Size() + b.PfbCounter.Size()
+	for start := 0; start < len(elements); {
+		end := start + 1
+		for end < len(elements) && elements[end].Blob.Namespace().Equals(elements[start].Blob.Namespace()) {
+			end++
+		}
+
+		// group the blobs of the namespace by subtree width, in order, as the
+		// padding needed by a blob only depends on its subtree width
+		widths := make([]int, 0)
+		queues := make(map[int][]*Element)
+		for _, element := range elements[start:end] {
+			width := inclusion.SubTreeWidth(element.NumShares, b.subtreeRootThreshold)
+			if _, ok := queues[width]; !ok {
+				widths = append(widths, width)
+			}
+			queues[width] = append(queues[width], element)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(widths)))
+
+		for i := start; i < end; i++ {
+			pick, minPadding := 0, -1
+			for _, width := range widths {
+				if len(queues[width]) == 0 {
+					continue
+				}
+				padding := inclusion.NextShareIndex(cursor, queues[width][0].NumShares, b.subtreeRootThreshold) - cursor
+				if minPadding == -1 || padding < minPadding {
+					pick, minPadding = width, padding
+				}
+			}
+			element := queues[pick][0]
+			queues[pick] = queues[pick][1:]
+			position[element] = i
+			cursor += minPadding + element.NumShares
+		}
+		start = end
+	}
+
+	return sortedPfbs(blobsByPfb, func(elements []*Element) int {
+		first := len(position)
+		for _, element := range elements {
+			if position[element] < first {
+				first = position[element]
+			}
+		}
+		return first
+	}, false)
+}
+
+// widthOrder orders the PFBs by decreasing subtree width of their widest blob.
+func (b *Builder) widthOrder(blobsByPfb [][]*Element) []int {
+	return sortedPfbs(blobsByPfb, func(elements []*Element) int {
+		widest := 0
+		for _, element := range elements {
+			if width := inclusion.SubTreeWidth(element.NumShares, b.subtreeRootThreshold); width > widest {
+				widest = width
+			}
+		}
+		return widest
+	}, true)
+}
+
+// sortedPfbs returns the indexes of the PFBs sorted by the provided key,
+// preserving the current order of PFBs with the same key.
+func sortedPfbs(blobsByPfb [][]*Element, key func([]*Element) int, descending bool) []int {
+	order := make([]int, len(blobsByPfb))
+	keys := make([]int, len(blobsByPfb))
+	for i, elements := range blobsByPfb {
+		order[i] = i
+		keys[i] = key(elements)
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if descending {
+			return keys[order[i]] > keys[order[j]]
+		}
+		return keys[order[i]] < keys[order[j]]
+	})
+	return order
+}
+
+// reorderPfbs reorders the PFBs, and their blobs, in the provided order.
+func (b *Builder) reorderPfbs(order []int, blobsByPfb [][]*Element) {
+	pfbs := make([]*coretypes.IndexWrapper, len(order))
+	blobs := make([]*Element, 0, len(b.Blobs))
+	for i, pfbIndex := range order {
+		pfbs[i] = b.Pfbs[pfbIndex]
+		for _, element := range blobsByPfb[pfbIndex] {
+			element.PfbIndex = i
+			blobs = append(blobs, element)
+		}
+	}
+	b.Pfbs = pfbs
+	b.Blobs = blobs
+	b.done = false
+}
@@ -0,0 +1,60 @@
+package square_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinimizePadding(t *testing.T) {
+	namespace := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	newBlobTx := func(id byte, shareCount int) []byte {
+		b := blob.New(namespace, make([]byte, blobSizeForShares(shareCount)), appconsts.ShareVersionZero)
+		blobTx, err := blob.MarshalBlobTx(bytes.Repeat([]byte{id}, 100), b)
+		require.NoError(t, err)
+		return blobTx
+	}
+
+	// the PFBs fit in a single share so the first blob can start at index 1,
+	// which requires a padding share for the wide blob
+	wide := newBlobTx(1, 65)
+	narrow := newBlobTx(2, 1)
+	txs := [][]byte{wide, narrow}
+
+	original, orderedTxs, err := square.Build(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+	require.NoError(t, err)
+	assert.Equal(t, txs, orderedTxs)
+	assert.Equal(t, 68, blobsEnd(t, original))
+
+	// each tx has its own signer
+	signers := func(tx []byte) []string {
+		return []string{string(tx)}
+	}
+	strategy := square.MinimizePaddingStrategy{Strategy: square.OrderPreservingStrategy{}, Signers: signers}
+	optimized, orderedTxs, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize, strategy)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{narrow, wide}, orderedTxs)
+	assert.Equal(t, 67, blobsEnd(t, optimized))
+	assert.Equal(t, original.Size(), optimized.Size())
+
+	constructed, err := square.Construct(orderedTxs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+	require.NoError(t, err)
+	assert.True(t, optimized.Equals(constructed))
+
+	// the order is kept if it doesn't reduce the padding
+	_, orderedTxs, err = square.BuildWithStrategy([][]byte{narrow, wide}, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize, strategy)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{narrow, wide}, orderedTxs)
+
+	// the txs of a signer keep their order
+	sameSigner := square.MinimizePaddingStrategy{Strategy: square.OrderPreservingStrategy{}}
+	_, orderedTxs, err = square.BuildWithStrategy(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize, sameSigner)
+	require.NoError(t, err)
+	assert.Equal(t, txs, orderedTxs)
+}
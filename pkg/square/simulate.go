@@ -0,0 +1,231 @@
+package square
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	"github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/tendermint/tendermint/pkg/consts"
+	coretypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// pfbTxFixedSize is a rough estimate, in bytes, of the size of a signed
+// PayForBlobs transaction without its blob infos.
+const pfbTxFixedSize = 270
+
+// EstimatePFBTxSize returns a rough estimate of the size in bytes of a signed
+// PayForBlobs transaction paying for blobCount blobs.
+func EstimatePFBTxSize(blobCount int) int {
+	return pfbTxFixedSize + blobtypes.BytesPerBlobInfo*blobCount
+}
+
+// BlobSpec describes a blob to simulate.
+type BlobSpec struct {
+	Namespace namespace.Namespace
+	// Size is the size of the blob data in bytes.
+	Size int
+}
+
+// PFBSpec describes a PayForBlobs transaction to simulate.
+type PFBSpec struct {
+	// TxSize is the size in bytes of the signed transaction. If zero, it is
+	// estimated with EstimatePFBTxSize.
+	TxSize int
+	Blobs  []BlobSpec
+}
+
+// SimulatedBlob describes where a simulated blob is laid out in the square.
+type SimulatedBlob struct {
+	// PFBIndex is the index of the PFB spec that pays for the blob.
+	PFBIndex  int `json:"pfb_index"`
+	BlobIndex int `json:"blob_index"`
+	// Namespace is the hex encoded namespace of the blob.
+	Namespace  string `json:"namespace"`
+	Size       int    `json:"size"`
+	ShareIndex int    `json:"share_index"`
+	ShareLen   int    `json:"share_len"`
+	// Padding is the number of namespace padding shares, or reserved padding
+	// shares for the first blob, preceding the blob.
+	Padding int `json:"padding"`
+}
+
+// SimulationResult describes the layout of the square built from a set of
+// simulated PFBs.
+type SimulationResult struct {
+	SquareSize    int `json:"square_size"`
+	MaxSquareSize int `json:"max_square_size"`
+	// MinSquareSize is the smallest square size that all the PFBs fit in
+	// following the non-interactive default rules. It can be smaller than the
+	// square size picked by the builder which reserves the worst case padding
+	// for each blob, and it can exceed the max square size.
+	MinSquareSize int `json:"min_square_size"`
+	// Fits is true if no PFB is dropped for lack of space.
+	Fits bool `json:"fits"`
+	// IncludedPFBs and DroppedPFBs are the indexes of the PFB specs that are
+	// included in the square and the ones that Build would drop.
+	IncludedPFBs []int `json:"included_pfbs"`
+	DroppedPFBs  []int `json:"dropped_pfbs"`
+
+	TotalShares            int `json:"total_shares"`
+	TxShares               int `json:"tx_shares"`
+	PFBShares              int `json:"pfb_shares"`
+	BlobShares             int `json:"blob_shares"`
+	ReservedPaddingShares  int `json:"reserved_padding_shares"`
+	NamespacePaddingShares int `json:"namespace_padding_shares"`
+	TailPaddingShares      int `json:"tail_padding_shares"`
+
+	// Blobs are the included blobs in the order they are laid out in.
+	Blobs []SimulatedBlob `json:"blobs"`
+}
+
+// PaddingShares returns the number of padding shares in the square.
+func (r SimulationResult) PaddingShares() int {
+	return r.ReservedPaddingShares + r.NamespacePaddingShares + r.TailPaddingShares
+}
+
+// Simulate builds a square from PFBs described by the size and namespace of
+// their blobs, without the need for signed transactions, and reports its
+// layout. Like Build, PFBs are appended in order and the ones that don't fit
+// in a square of maxSquareSize are dropped.
+func Simulate(pfbs []PFBSpec, appVersion uint64, maxSquareSize int) (SimulationResult, error) {
+	builder, err := NewBuilder(maxSquareSize, appVersion)
+	if err != nil {
+		return SimulationResult{}, err
+	}
+	result := SimulationResult{
+		MaxSquareSize: maxSquareSize,
+		IncludedPFBs:  make([]int, 0, len(pfbs)),
+		DroppedPFBs:   make([]int, 0),
+		Blobs:         make([]SimulatedBlob, 0),
+	}
+	for i, pfb := range pfbs {
+		blobTx, err := simulatedBlobTx(pfb)
+		if err != nil {
+			return SimulationResult{}, fmt.Errorf("pfb %d: %w", i, err)
+		}
+		if builder.AppendBlobTx(blobTx) {
+			result.IncludedPFBs = append(result.IncludedPFBs, i)
+		} else {
+			result.DroppedPFBs = append(result.DroppedPFBs, i)
+		}
+	}
+	result.Fits = len(result.DroppedPFBs) == 0
+	result.MinSquareSize = minSquareSize(pfbs, appVersion)
+
+	square, err := builder.Export()
+	if err != nil {
+		return SimulationResult{}, err
+	}
+	infos, _, err := InspectShares(square, builder.SubtreeRootThreshold())
+	if err != nil {
+		return SimulationResult{}, err
+	}
+	result.SquareSize = square.Size()
+	result.TotalShares = len(square)
+	txNamespace := hex.EncodeToString(namespace.TxNamespace.Bytes())
+	pfbNamespace := hex.EncodeToString(namespace.PayForBlobNamespace.Bytes())
+	for _, info := range infos {
+		switch {
+		case info.Padding == ReservedPadding:
+			result.ReservedPaddingShares++
+		case info.Padding == NamespacePadding:
+			result.NamespacePaddingShares++
+		case info.Padding == TailPadding:
+			result.TailPaddingShares++
+		case info.Namespace == txNamespace:
+			result.TxShares++
+		case info.Namespace == pfbNamespace:
+			result.PFBShares++
+		}
+	}
+
+	endOfLastBlob := result.TxShares + result.PFBShares
+	for _, element := range builder.Blobs {
+		start, err := builder.FindBlobStartingIndex(len(builder.Txs)+element.PfbIndex, element.BlobIndex)
+		if err != nil {
+			return SimulationResult{}, err
+		}
+		result.BlobShares += element.NumShares
+		result.Blobs = append(result.Blobs, SimulatedBlob{
+			PFBIndex:   result.IncludedPFBs[element.PfbIndex],
+			BlobIndex:  element.BlobIndex,
+			Namespace:  hex.EncodeToString(element.Blob.Namespace().Bytes()),
+			Size:       len(element.Blob.Data),
+			ShareIndex: start,
+			ShareLen:   element.NumShares,
+			Padding:    start - endOfLastBlob,
+		})
+		endOfLastBlob = start + element.NumShares
+	}
+	return result, nil
+}
+
+// simulatedBlobTx returns a blob tx with zeroed blobs of the sizes of the spec
+// and a placeholder transaction of the size of the spec.
+func simulatedBlobTx(pfb PFBSpec) (blob.BlobTx, error) {
+	if len(pfb.Blobs) == 0 {
+		return blob.BlobTx{}, errors.New("a PFB must contain at least one blob")
+	}
+	if pfb.TxSize < 0 {
+		return blob.BlobTx{}, fmt.Errorf("tx size %d must not be negative", pfb.TxSize)
+	}
+	txSize := pfb.TxSize
+	if txSize == 0 {
+		txSize = EstimatePFBTxSize(len(pfb.Blobs))
+	}
+	blobs := make([]*blob.Blob, len(pfb.Blobs))
+	for i, spec := range pfb.Blobs {
+		if spec.Size <= 0 {
+			return blob.BlobTx{}, fmt.Errorf("blob %d: size %d must be positive", i, spec.Size)
+		}
+		if err := blobtypes.ValidateBlobNamespace(spec.Namespace); err != nil {
+			return blob.BlobTx{}, fmt.Errorf("blob %d: %w", i, err)
+		}
+		blobs[i] = blob.New(spec.Namespace, make([]byte, spec.Size), appconsts.ShareVersionZero)
+	}
+	return blob.BlobTx{Tx: make([]byte, txSize), Blobs: blobs}, nil
+}
+
+// minSquareSize returns the smallest square size that fits all the PFBs when
+// their blobs are laid out following the non-interactive default rules.
+func minSquareSize(pfbs []PFBSpec, appVersion uint64) int {
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	pfbCounter := shares.NewCompactShareCounter()
+	blobs := make([]BlobSpec, 0, len(pfbs))
+	for _, pfb := range pfbs {
+		txSize := pfb.TxSize
+		if txSize == 0 {
+			txSize = EstimatePFBTxSize(len(pfb.Blobs))
+		}
+		iw := &coretypes.IndexWrapper{
+			Tx:           make([]byte, txSize),
+			TypeId:       consts.ProtoIndexWrapperTypeID,
+			ShareIndexes: worstCaseShareIndexes(len(pfb.Blobs), appVersion),
+		}
+		pfbCounter.Add(iw.Size())
+		blobs = append(blobs, pfb.Blobs...)
+	}
+	if len(blobs) == 0 {
+		return inclusion.BlobMinSquareSize(pfbCounter.Size())
+	}
+
+	// blobs are laid out in namespace order, see Builder.Export
+	sort.SliceStable(blobs, func(i, j int) bool {
+		return bytes.Compare(blobs[i].Namespace.Bytes(), blobs[j].Namespace.Bytes()) < 0
+	})
+	blobShareLens := make([]int, len(blobs))
+	for i, spec := range blobs {
+		blobShareLens[i] = shares.SparseSharesNeeded(uint32(spec.Size))
+	}
+	cursor := inclusion.NextShareIndex(pfbCounter.Size(), blobShareLens[0], subtreeRootThreshold)
+	sharesUsed, _ := inclusion.BlobSharesUsedNonInteractiveDefaults(cursor, subtreeRootThreshold, blobShareLens...)
+	return inclusion.BlobMinSquareSize(cursor + sharesUsed)
+}
@@ -0,0 +1,156 @@
+package square_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestSimulateMatchesBuild(t *testing.T) {
+	rand := tmrand.NewRand()
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := blobfactory.RandBlobTxsRandomlySized(signer, rand, 50, 100000, 5).ToSliceOfBytes()
+
+	specs := make([]square.PFBSpec, len(txs))
+	for i, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		require.True(t, isBlobTx)
+		specs[i].TxSize = len(blobTx.Tx)
+		for _, b := range blobTx.Blobs {
+			specs[i].Blobs = append(specs[i].Blobs, square.BlobSpec{Namespace: b.Namespace(), Size: len(b.Data)})
+		}
+	}
+
+	maxSquareSize := appconsts.DefaultGovMaxSquareSize
+	result, err := square.Simulate(specs, appconsts.LatestVersion, maxSquareSize)
+	require.NoError(t, err)
+	dataSquare, includedTxs, err := square.Build(txs, appconsts.LatestVersion, maxSquareSize)
+	require.NoError(t, err)
+
+	assert.Equal(t, dataSquare.Size(), result.SquareSize)
+	assert.Equal(t, len(dataSquare), result.TotalShares)
+	assert.Len(t, result.IncludedPFBs, len(includedTxs))
+	assert.Equal(t, len(txs), len(result.IncludedPFBs)+len(result.DroppedPFBs))
+	assert.Equal(t, len(result.DroppedPFBs) == 0, result.Fits)
+	assert.Equal(t, result.TotalShares, result.TxShares+result.PFBShares+result.BlobShares+result.PaddingShares())
+	if result.Fits {
+		assert.LessOrEqual(t, result.MinSquareSize, result.SquareSize)
+	}
+
+	// the blobs are laid out at the same indexes as in the built square
+	builder, err := square.NewBuilder(maxSquareSize, appconsts.LatestVersion, includedTxs...)
+	require.NoError(t, err)
+	for _, b := range result.Blobs {
+		txIndex := indexOf(t, includedTxs, txs[b.PFBIndex])
+		start, err := builder.FindBlobStartingIndex(txIndex, b.BlobIndex)
+		require.NoError(t, err)
+		assert.Equal(t, start, b.ShareIndex)
+		shareLen, err := builder.BlobShareLength(txIndex, b.BlobIndex)
+		require.NoError(t, err)
+		assert.Equal(t, shareLen, b.ShareLen)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	ns1 := ns.MustNewV0(bytes.Repeat([]byte{1}, ns.NamespaceVersionZeroIDSize))
+	ns2 := ns.MustNewV0(bytes.Repeat([]byte{2}, ns.NamespaceVersionZeroIDSize))
+	t.Run("blobs are laid out in namespace order with padding", func(t *testing.T) {
+		specs := []square.PFBSpec{
+			{Blobs: []square.BlobSpec{{Namespace: ns2, Size: 100}}},
+			{Blobs: []square.BlobSpec{{Namespace: ns1, Size: 100}, {Namespace: ns1, Size: blobSizeForShares(129)}}},
+		}
+		result, err := square.Simulate(specs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+		require.NoError(t, err)
+		assert.True(t, result.Fits)
+		assert.Equal(t, []int{0, 1}, result.IncludedPFBs)
+		assert.Empty(t, result.DroppedPFBs)
+		assert.Equal(t, 16, result.SquareSize)
+		assert.Equal(t, 16, result.MinSquareSize)
+		assert.Equal(t, 0, result.TxShares)
+		assert.Equal(t, 2, result.PFBShares)
+		assert.Equal(t, 1+129+1, result.BlobShares)
+		require.Len(t, result.Blobs, 3)
+		assert.Equal(t, hex.EncodeToString(ns1.Bytes()), result.Blobs[0].Namespace)
+		assert.Equal(t, 1, result.Blobs[0].PFBIndex)
+		assert.Equal(t, 2, result.Blobs[0].ShareIndex)
+		// the second blob has a subtree width of 4 so it starts at a multiple of 4
+		assert.Equal(t, 1, result.Blobs[1].PFBIndex)
+		assert.Equal(t, 4, result.Blobs[1].ShareIndex)
+		assert.Equal(t, 129, result.Blobs[1].ShareLen)
+		assert.Equal(t, 1, result.Blobs[1].Padding)
+		assert.Equal(t, hex.EncodeToString(ns2.Bytes()), result.Blobs[2].Namespace)
+		assert.Equal(t, 0, result.Blobs[2].PFBIndex)
+		assert.Equal(t, 133, result.Blobs[2].ShareIndex)
+		assert.Equal(t, 0, result.ReservedPaddingShares)
+		assert.Equal(t, 1, result.NamespacePaddingShares)
+		assert.Equal(t, 256-134, result.TailPaddingShares)
+	})
+
+	t.Run("PFBs that don't fit are dropped", func(t *testing.T) {
+		spec := square.PFBSpec{TxSize: 100, Blobs: []square.BlobSpec{{Namespace: ns1, Size: blobSizeForShares(100)}}}
+		result, err := square.Simulate([]square.PFBSpec{spec, spec, spec}, appconsts.LatestVersion, 16)
+		require.NoError(t, err)
+		assert.False(t, result.Fits)
+		assert.Equal(t, []int{0, 1}, result.IncludedPFBs)
+		assert.Equal(t, []int{2}, result.DroppedPFBs)
+		assert.Equal(t, 16, result.SquareSize)
+		assert.Equal(t, 32, result.MinSquareSize)
+		assert.Equal(t, 1, result.PFBShares)
+		require.Len(t, result.Blobs, 2)
+		// the reserved padding aligns the first blob with its subtree width of 2
+		assert.Equal(t, 2, result.Blobs[0].ShareIndex)
+		assert.Equal(t, 1, result.Blobs[0].Padding)
+		assert.Equal(t, 102, result.Blobs[1].ShareIndex)
+		assert.Equal(t, 0, result.Blobs[1].Padding)
+		assert.Equal(t, 1, result.ReservedPaddingShares)
+		assert.Equal(t, 256-202, result.TailPaddingShares)
+	})
+
+	t.Run("no PFBs", func(t *testing.T) {
+		result, err := square.Simulate(nil, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+		require.NoError(t, err)
+		assert.True(t, result.Fits)
+		assert.Equal(t, 1, result.SquareSize)
+		assert.Equal(t, 1, result.TailPaddingShares)
+	})
+
+	t.Run("invalid specs", func(t *testing.T) {
+		invalid := []square.PFBSpec{
+			{},
+			{Blobs: []square.BlobSpec{{Namespace: ns1}}},
+			{Blobs: []square.BlobSpec{{Namespace: ns.TxNamespace, Size: 100}}},
+			{TxSize: -1, Blobs: []square.BlobSpec{{Namespace: ns1, Size: 100}}},
+		}
+		for _, spec := range invalid {
+			_, err := square.Simulate([]square.PFBSpec{spec}, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+			assert.Error(t, err)
+		}
+	})
+}
+
+func indexOf(t *testing.T, txs [][]byte, tx []byte) int {
+	for i := range txs {
+		if string(txs[i]) == string(tx) {
+			return i
+		}
+	}
+	t.Fatalf("tx not found")
+	return -1
+}
+
+// blobSizeForShares returns the size of a blob that spans exactly shareCount
+// sparse shares.
+func blobSizeForShares(shareCount int) int {
+	return appconsts.FirstSparseShareContentSize + (shareCount-1)*appconsts.ContinuationSparseShareContentSize
+}
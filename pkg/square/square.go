@@ -20,26 +20,19 @@ import (
 // not check the underlying validity of the transactions.
 // Errors should not occur and would reflect a violation in an invariant.
 func Build(txs [][]byte, appVersion uint64, maxSquareSize int) (Square, [][]byte, error) {
+	return BuildWithStrategy(txs, appVersion, maxSquareSize, OrderPreservingStrategy{})
+}
+
+// BuildWithStrategy is like Build but uses the provided packing strategy to
+// decide which transactions are part of the square and in which order.
+func BuildWithStrategy(txs [][]byte, appVersion uint64, maxSquareSize int, strategy PackingStrategy) (Square, [][]byte, error) {
 	builder, err := NewBuilder(maxSquareSize, appVersion)
 	if err != nil {
 		return nil, nil, err
 	}
-	normalTxs := make([][]byte, 0, len(txs))
-	blobTxs := make([][]byte, 0, len(txs))
-	for _, tx := range txs {
-		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
-		if isBlobTx {
-			if builder.AppendBlobTx(blobTx) {
-				blobTxs = append(blobTxs, tx)
-			}
-		} else {
-			if builder.AppendTx(tx) {
-				normalTxs = append(normalTxs, tx)
-			}
-		}
-	}
+	orderedTxs := strategy.Pack(builder, txs)
 	square, err := builder.Export()
-	return square, append(normalTxs, blobTxs...), err
+	return square, orderedTxs, err
 }
 
 // Construct takes the exact list of ordered transactions and constructs a square, validating that
@@ -7,6 +7,8 @@ import (
 	tmrand "github.com/tendermint/tendermint/libs/rand"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
 	"github.com/celestiaorg/celestia-app/test/util/testnode"
 	"github.com/stretchr/testify/require"
@@ -54,3 +56,37 @@ func BenchmarkSquareBuild(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkSquareExtend(b *testing.B) {
+	for _, squareSize := range []int{32, 64, 128} {
+		signer, err := testnode.NewOfflineSigner()
+		require.NoError(b, err)
+		// fill the square with blobs of the size of a row
+		blobCount := squareSize - 2
+		blobSize := shares.AvailableBytesFromSparseShares(squareSize)
+		txs := generateMixedTxs(signer, tmrand.NewRand(), 0, blobCount, 1, blobSize)
+		dataSquare, _, err := square.Build(txs, appconsts.LatestVersion, squareSize)
+		require.NoError(b, err)
+		require.Equal(b, squareSize, dataSquare.Size())
+		rawShares := shares.ToBytes(dataSquare)
+
+		b.Run(fmt.Sprintf("squareSize=%d serial", squareSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				eds, err := da.ExtendShares(rawShares)
+				require.NoError(b, err)
+				_, err = da.NewDataAvailabilityHeader(eds)
+				require.NoError(b, err)
+			}
+		})
+		for _, workers := range []int{1, 4, 0} {
+			b.Run(fmt.Sprintf("squareSize=%d workers=%d", squareSize, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					eds, err := da.ExtendSharesParallel(rawShares, workers)
+					require.NoError(b, err)
+					_, err = da.NewDataAvailabilityHeaderParallel(eds, workers)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
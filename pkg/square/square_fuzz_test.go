@@ -1,20 +1,31 @@
 package square_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	pkgblob "github.com/celestiaorg/celestia-app/pkg/blob"
 	"github.com/celestiaorg/celestia-app/pkg/da"
 	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
 	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testnode"
 	blob "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/celestiaorg/rsmt2d"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 // FuzzSquare uses fuzzing to test the following:
@@ -57,34 +68,197 @@ func FuzzSquare(f *testing.F) {
 		require.NoError(t, err)
 		require.Equal(t, orderedTxs, recomputedTxs.ToSliceOfBytes())
 
-		cacher := inclusion.NewSubtreeCacher(uint64(s.Size()))
-		eds, err := rsmt2d.ComputeExtendedDataSquare(shares.ToBytes(s), appconsts.DefaultCodec(), cacher.Constructor)
+		requireValidCommitments(t, s, orderedTxs)
+	})
+}
+
+// FuzzSquareMinimizePadding uses fuzzing to test that reordering the blob
+// transactions to reduce padding:
+// - is deterministic
+// - keeps the same set of transactions and never increases the padding
+// - keeps the order of the transactions of each signer
+// - results in a square that `Construct` rebuilds identically from the
+// reordered transactions
+// - results in PFBs whose share commitments verify the inclusion of their blobs
+// - results in proposals that ProcessProposal accepts
+func FuzzSquareMinimizePadding(f *testing.F) {
+	var (
+		pfbCount       = 40
+		namespaceCount = 3
+		seed           = int64(8675309)
+	)
+	f.Add(pfbCount, namespaceCount, seed)
+
+	opts := testnode.DefaultAppOptions()
+	opts.Set(app.FlagMinimizePadding, true)
+	accounts := testfactory.GenerateAccounts(4)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndOptions(app.DefaultConsensusParams(), opts, accounts...)
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txSigners := func(tx []byte) []string {
+		sdkTx, err := encCfg.TxConfig.TxDecoder()(tx)
+		if err != nil {
+			return nil
+		}
+		signers := make([]string, 0, 1)
+		for _, signer := range sdkTx.(authsigning.SigVerifiableTx).GetSigners() {
+			signers = append(signers, signer.String())
+		}
+		return signers
+	}
+
+	f.Fuzz(func(t *testing.T, pfbCount, namespaceCount int, seed int64) {
+		// ignore invalid values and PFB counts that are too slow to generate
+		if pfbCount < 0 || pfbCount > 200 || namespaceCount <= 0 {
+			t.Skip()
+		}
+		rand := tmrand.NewRand()
+		rand.Seed(seed)
+		// the test app doesn't commit blocks so the signers start from the
+		// genesis sequences in every run
+		signers := make([]*user.Signer, len(accounts))
+		for i, account := range accounts {
+			addr := testfactory.GetAddress(kr, account)
+			acc := testutil.DirectQueryAccount(testApp, addr)
+			signer, err := user.NewSigner(kr, nil, addr, encCfg.TxConfig, testutil.ChainID, acc.GetAccountNumber(), acc.GetSequence())
+			require.NoError(t, err)
+			signers[i] = signer
+		}
+		txs := generateSharedNamespaceBlobTxs(t, signers, rand, pfbCount, namespaceCount)
+
+		// keep the transactions that fit in the square so that no sequence is
+		// skipped by the original order
+		original, originalTxs, err := square.Build(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
 		require.NoError(t, err)
-		dah, err := da.NewDataAvailabilityHeader(eds)
+		for i := range originalTxs {
+			if !bytes.Equal(originalTxs[i], txs[i]) {
+				txs = txs[:i]
+				original, originalTxs, err = square.Build(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+				require.NoError(t, err)
+				break
+			}
+		}
+		txs = originalTxs
+
+		strategy := square.MinimizePaddingStrategy{Strategy: square.OrderPreservingStrategy{}, Signers: txSigners}
+		s, orderedTxs, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize, strategy)
+		require.NoError(t, err)
+		s2, orderedTxs2, err := square.BuildWithStrategy(txs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize, strategy)
 		require.NoError(t, err)
+		require.True(t, s.Equals(s2))
+		require.Equal(t, orderedTxs, orderedTxs2)
 
-		decoder := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.TxDecoder()
+		require.ElementsMatch(t, originalTxs, orderedTxs)
+		require.Equal(t, original.Size(), s.Size())
+		require.LessOrEqual(t, blobsEnd(t, s), blobsEnd(t, original))
+		requireSignerOrder(t, txs, orderedTxs, txSigners)
 
-		builder, err := square.NewBuilder(appconsts.DefaultSquareSizeUpperBound, appconsts.LatestVersion, orderedTxs...)
+		constructed, err := square.Construct(orderedTxs, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
 		require.NoError(t, err)
-		totalPfbs := builder.NumPFBs()
-		totalNormalTxs := builder.NumTxs() - totalPfbs
-		for pfbIndex := 0; pfbIndex < totalPfbs; pfbIndex++ {
-			wpfb, err := builder.GetWrappedPFB(pfbIndex + totalNormalTxs)
-			require.NoError(t, err)
-			tx, err := decoder(wpfb.Tx)
-			require.NoError(t, err)
+		require.True(t, s.Equals(constructed))
 
-			pfb, ok := tx.GetMsgs()[0].(*blob.MsgPayForBlobs)
-			require.True(t, ok)
+		requireValidCommitments(t, s, orderedTxs)
 
-			for blobIndex, shareIndex := range wpfb.ShareIndexes {
-				commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex]), appconsts.DefaultSubtreeRootThreshold)
-				require.NoError(t, err)
-				require.Equal(t, pfb.ShareCommitments[blobIndex], commitment)
+		height := testApp.LastBlockHeight() + 1
+		resp := testApp.PrepareProposal(abci.RequestPrepareProposal{
+			BlockData: &tmproto.Data{Txs: txs},
+			ChainId:   testutil.ChainID,
+			Height:    height,
+			Time:      time.Now(),
+		})
+		require.Equal(t, orderedTxs, resp.BlockData.Txs)
+		res := testApp.ProcessProposal(abci.RequestProcessProposal{
+			BlockData: resp.BlockData,
+			Header: tmproto.Header{
+				Height:   height,
+				DataHash: resp.BlockData.Hash,
+				ChainID:  testutil.ChainID,
+			},
+		})
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Result)
+	})
+}
+
+// requireSignerOrder checks that the transactions of each signer are in the
+// same relative order in both lists.
+func requireSignerOrder(t *testing.T, txs, orderedTxs [][]byte, txSigners square.SignersFunc) {
+	bySigner := func(txs [][]byte) map[string][][]byte {
+		m := make(map[string][][]byte)
+		for _, tx := range txs {
+			blobTx, isBlobTx := pkgblob.UnmarshalBlobTx(tx)
+			require.True(t, isBlobTx)
+			for _, signer := range txSigners(blobTx.Tx) {
+				m[signer] = append(m[signer], tx)
 			}
 		}
-	})
+		return m
+	}
+	require.Equal(t, bySigner(txs), bySigner(orderedTxs))
+}
+
+// requireValidCommitments checks that each share commitment in each PFB of
+// the square can be used to verify the inclusion of the blob it corresponds to.
+func requireValidCommitments(t *testing.T, s square.Square, orderedTxs [][]byte) {
+	cacher := inclusion.NewSubtreeCacher(uint64(s.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(shares.ToBytes(s), appconsts.DefaultCodec(), cacher.Constructor)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	decoder := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.TxDecoder()
+
+	builder, err := square.NewBuilder(appconsts.DefaultSquareSizeUpperBound, appconsts.LatestVersion, orderedTxs...)
+	require.NoError(t, err)
+	totalPfbs := builder.NumPFBs()
+	totalNormalTxs := builder.NumTxs() - totalPfbs
+	for pfbIndex := 0; pfbIndex < totalPfbs; pfbIndex++ {
+		wpfb, err := builder.GetWrappedPFB(pfbIndex + totalNormalTxs)
+		require.NoError(t, err)
+		tx, err := decoder(wpfb.Tx)
+		require.NoError(t, err)
+
+		pfb, ok := tx.GetMsgs()[0].(*blob.MsgPayForBlobs)
+		require.True(t, ok)
+
+		for blobIndex, shareIndex := range wpfb.ShareIndexes {
+			commitment, err := inclusion.GetCommitment(cacher, dah, int(shareIndex), shares.SparseSharesNeeded(pfb.BlobSizes[blobIndex]), appconsts.DefaultSubtreeRootThreshold)
+			require.NoError(t, err)
+			require.Equal(t, pfb.ShareCommitments[blobIndex], commitment)
+		}
+	}
+}
+
+// generateSharedNamespaceBlobTxs generates PFBs of one to three blobs of up to
+// 200 shares, which is wide enough to need padding, in one of namespaceCount
+// namespaces. Each PFB is signed by one of the signers, with consecutive
+// sequences for each signer.
+func generateSharedNamespaceBlobTxs(t *testing.T, signers []*user.Signer, rand *tmrand.Rand, pfbCount, namespaceCount int) [][]byte {
+	namespaces := make([]appns.Namespace, namespaceCount)
+	for i := range namespaces {
+		namespaces[i] = appns.RandomBlobNamespaceWithPRG(rand)
+	}
+	txs := make([][]byte, pfbCount)
+	for i := range txs {
+		blobs := make([]*pkgblob.Blob, rand.Intn(3)+1)
+		for j := range blobs {
+			size := rand.Intn(200*appconsts.ContinuationSparseShareContentSize) + 1
+			blobs[j] = pkgblob.New(namespaces[rand.Intn(namespaceCount)], rand.Bytes(size), appconsts.ShareVersionZero)
+		}
+		tx, err := signers[rand.Intn(len(signers))].CreatePayForBlob(blobs, blobfactory.DefaultTxOpts()...)
+		require.NoError(t, err)
+		txs[i] = tx
+	}
+	return txs
+}
+
+// blobsEnd returns the index following the last blob of the square.
+func blobsEnd(t *testing.T, s square.Square) int {
+	infos, _, err := square.InspectShares(s, appconsts.DefaultSubtreeRootThreshold)
+	require.NoError(t, err)
+	end := len(infos)
+	for end > 0 && infos[end-1].Padding == square.TailPadding {
+		end--
+	}
+	return end
 }
 
 // contains checks whether subTxs is a subset of allTxs.
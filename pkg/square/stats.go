@@ -0,0 +1,90 @@
+package square
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+)
+
+// Stats describes the layout of a square constructed by a Builder.
+type Stats struct {
+	SquareSize int `json:"square_size"`
+	// Txs and PFBs are the number of normal and blob transactions in the
+	// square.
+	Txs   int `json:"txs"`
+	PFBs  int `json:"pfbs"`
+	Blobs int `json:"blobs"`
+	// DroppedTxs and DroppedPFBs are the number of normal and blob
+	// transactions that were not appended to the builder for lack of space.
+	DroppedTxs  int `json:"dropped_txs"`
+	DroppedPFBs int `json:"dropped_pfbs"`
+
+	TxShares               int `json:"tx_shares"`
+	PFBShares              int `json:"pfb_shares"`
+	BlobShares             int `json:"blob_shares"`
+	ReservedPaddingShares  int `json:"reserved_padding_shares"`
+	NamespacePaddingShares int `json:"namespace_padding_shares"`
+	TailPaddingShares      int `json:"tail_padding_shares"`
+
+	// BlobBytes is the total size of the data of the blobs.
+	BlobBytes int `json:"blob_bytes"`
+	// Namespaces are the hex encoded namespaces of the blobs, in order.
+	Namespaces []string `json:"namespaces"`
+}
+
+// PaddingShares returns the number of padding shares in the square.
+func (s Stats) PaddingShares() int {
+	return s.ReservedPaddingShares + s.NamespacePaddingShares + s.TailPaddingShares
+}
+
+// BlobEfficiency returns the ratio of the blob bytes to the bytes of the
+// shares they occupy, or zero if there are no blobs.
+func (s Stats) BlobEfficiency() float64 {
+	if s.BlobShares == 0 {
+		return 0
+	}
+	return float64(s.BlobBytes) / float64(s.BlobShares*appconsts.ShareSize)
+}
+
+// Stats returns the layout of the square, constructing it if needed.
+func (b *Builder) Stats() (Stats, error) {
+	if !b.done {
+		if _, err := b.Export(); err != nil {
+			return Stats{}, fmt.Errorf("building square: %w", err)
+		}
+	}
+	return b.stats, nil
+}
+
+// newStats describes the layout of the exported square, where blobs have
+// been sorted by namespace.
+func (b *Builder) newStats(squareSize, txShares, pfbShares, nonReservedStart, namespacePadding int) Stats {
+	stats := Stats{
+		SquareSize:             squareSize,
+		Txs:                    len(b.Txs),
+		PFBs:                   len(b.Pfbs),
+		Blobs:                  len(b.Blobs),
+		DroppedTxs:             b.droppedTxs,
+		DroppedPFBs:            b.droppedPfbs,
+		TxShares:               txShares,
+		PFBShares:              pfbShares,
+		NamespacePaddingShares: namespacePadding,
+		Namespaces:             make([]string, 0),
+	}
+	for _, element := range b.Blobs {
+		stats.BlobShares += element.NumShares
+		stats.BlobBytes += len(element.Blob.Data)
+		namespace := hex.EncodeToString(element.Blob.Namespace().Bytes())
+		if len(stats.Namespaces) == 0 || stats.Namespaces[len(stats.Namespaces)-1] != namespace {
+			stats.Namespaces = append(stats.Namespaces, namespace)
+		}
+	}
+	endOfLastBlob := nonReservedStart
+	if len(b.Blobs) > 0 {
+		stats.ReservedPaddingShares = nonReservedStart - txShares - pfbShares
+		endOfLastBlob += namespacePadding + stats.BlobShares
+	}
+	stats.TailPaddingShares = squareSize*squareSize - endOfLastBlob
+	return stats
+}
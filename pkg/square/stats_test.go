@@ -0,0 +1,92 @@
+package square_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	ns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestBuilderStats(t *testing.T) {
+	rand := tmrand.NewRand()
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := coretypes.Txs(blobfactory.GenerateManyRawSendTxs(signer, 10)).ToSliceOfBytes()
+	txs = append(txs, blobfactory.RandBlobTxsRandomlySized(signer, rand, 40, 20000, 3).ToSliceOfBytes()...)
+
+	for _, maxSquareSize := range []int{8, 32, appconsts.DefaultGovMaxSquareSize} {
+		builder, err := square.NewBuilder(maxSquareSize, appconsts.LatestVersion)
+		require.NoError(t, err)
+		orderedTxs := square.OrderPreservingStrategy{}.Pack(builder, txs)
+		dataSquare, err := builder.Export()
+		require.NoError(t, err)
+		stats, err := builder.Stats()
+		require.NoError(t, err)
+
+		assert.Equal(t, dataSquare.Size(), stats.SquareSize)
+		assert.Equal(t, len(orderedTxs), stats.Txs+stats.PFBs)
+		assert.Equal(t, len(txs), len(orderedTxs)+stats.DroppedTxs+stats.DroppedPFBs)
+
+		// the stats match the shares of the square
+		infos, _, err := square.InspectShares(dataSquare, appconsts.DefaultSubtreeRootThreshold)
+		require.NoError(t, err)
+		padding := make(map[square.PaddingKind]int)
+		txShares, pfbShares, blobShares := 0, 0, 0
+		namespaces := make([]string, 0)
+		for _, info := range infos {
+			switch {
+			case info.Padding != square.NoPadding:
+				padding[info.Padding]++
+			case info.Namespace == hex.EncodeToString(ns.TxNamespace.Bytes()):
+				txShares++
+			case info.Namespace == hex.EncodeToString(ns.PayForBlobNamespace.Bytes()):
+				pfbShares++
+			default:
+				blobShares++
+				if len(namespaces) == 0 || namespaces[len(namespaces)-1] != info.Namespace {
+					namespaces = append(namespaces, info.Namespace)
+				}
+			}
+		}
+		assert.Equal(t, txShares, stats.TxShares)
+		assert.Equal(t, pfbShares, stats.PFBShares)
+		assert.Equal(t, blobShares, stats.BlobShares)
+		assert.Equal(t, padding[square.NamespacePadding], stats.NamespacePaddingShares)
+		assert.Equal(t, padding[square.ReservedPadding], stats.ReservedPaddingShares)
+		assert.Equal(t, padding[square.TailPadding], stats.TailPaddingShares)
+		assert.Equal(t, len(dataSquare), stats.TxShares+stats.PFBShares+stats.BlobShares+stats.PaddingShares())
+		assert.Equal(t, namespaces, stats.Namespaces)
+		assert.Greater(t, stats.BlobEfficiency(), 0.0)
+		assert.LessOrEqual(t, stats.BlobEfficiency(), 1.0)
+	}
+}
+
+func TestBuilderStatsEmptySquare(t *testing.T) {
+	builder, err := square.NewBuilder(appconsts.DefaultGovMaxSquareSize, appconsts.LatestVersion)
+	require.NoError(t, err)
+	stats, err := builder.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.SquareSize)
+	assert.Equal(t, 1, stats.PaddingShares())
+	assert.Zero(t, stats.BlobEfficiency())
+
+	// a blob that can't fit in the square is dropped
+	namespace := ns.MustNewV0([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	b := blob.New(namespace, make([]byte, blobSizeForShares(2)), appconsts.ShareVersionZero)
+	builder, err = square.NewBuilder(1, appconsts.LatestVersion)
+	require.NoError(t, err)
+	assert.False(t, builder.AppendBlobTx(blob.BlobTx{Tx: make([]byte, 100), Blobs: []*blob.Blob{b}}))
+	stats, err = builder.Stats()
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.DroppedPFBs)
+	assert.Zero(t, stats.PFBs)
+}
@@ -0,0 +1,159 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	// DefaultGasMultiplier is the multiplier applied to the gas used by a
+	// simulated transaction to get its gas limit. The gas used may differ
+	// slightly between the simulation and the actual execution.
+	DefaultGasMultiplier = 1.1
+
+	// FeeGrantGasCost is the extra gas required by a PayForBlobs transaction
+	// whose fees are paid by a fee granter.
+	FeeGrantGasCost = 12000
+)
+
+// SetGasPrice sets the gas price, in utia, used to derive the fee of the
+// transactions whose gas limit is estimated by the signer.
+func (s *Signer) SetGasPrice(gasPrice float64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.gasPrice = gasPrice
+}
+
+// GasPrice returns the gas price used to derive the fee of the transactions
+// whose gas limit is estimated by the signer.
+func (s *Signer) GasPrice() float64 {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.gasPrice
+}
+
+// SetGasMultiplier sets the multiplier applied to the gas used by simulated
+// transactions to get their gas limit.
+func (s *Signer) SetGasMultiplier(multiplier float64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.gasMultiplier = multiplier
+}
+
+// EstimateGas estimates the gas limit of a transaction containing the
+// provided messages by simulating it against the node. TxOptions such as the
+// fee granter affect the simulated gas.
+func (s *Signer) EstimateGas(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (uint64, error) {
+	txBuilder := s.txBuilder(opts...)
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return 0, err
+	}
+	if err := s.checkSigner(txBuilder); err != nil {
+		return 0, err
+	}
+	// deducting the fee consumes gas so the simulated transaction must have a
+	// fee, even though the actual fee depends on the estimated gas.
+	if txBuilder.GetTx().GetFee().IsZero() {
+		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, 1)))
+	}
+
+	// sign the transaction with the next sequence so that the simulation
+	// matches the execution of the transaction once it is submitted.
+	s.mtx.RLock()
+	sequence, multiplier := s.lastSignedSequence, s.gasMultiplier
+	s.mtx.RUnlock()
+	if err := s.signTransactionWithSequence(txBuilder, sequence); err != nil {
+		return 0, err
+	}
+
+	txBytes, err := s.enc.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := tx.NewServiceClient(s.grpc).Simulate(ctx, &tx.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, fmt.Errorf("simulating tx: %w", err)
+	}
+
+	return uint64(math.Ceil(float64(resp.GasInfo.GasUsed) * multiplier)), nil
+}
+
+// EstimatePayForBlobGas estimates the gas limit of a PayForBlobs transaction
+// for the provided blobs. The gas consumed by the blobs is computed from the
+// on-chain GasPerBlobByte and TxSizeCostPerByte params, so the transaction
+// doesn't need to be simulated.
+func (s *Signer) EstimatePayForBlobGas(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (uint64, error) {
+	if len(blobs) == 0 {
+		return 0, errors.New("at least one blob is required")
+	}
+	blobParams, err := blobtypes.NewQueryClient(s.grpc).Params(ctx, &blobtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying blob params: %w", err)
+	}
+	authParams, err := authtypes.NewQueryClient(s.grpc).Params(ctx, &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("querying auth params: %w", err)
+	}
+
+	blobSizes := make([]uint32, len(blobs))
+	for i, b := range blobs {
+		blobSizes[i] = uint32(len(b.Data))
+	}
+	gas := blobtypes.EstimateGas(blobSizes, blobParams.Params.GasPerBlobByte, authParams.Params.TxSizeCostPerByte)
+
+	// account for the extra gas required to deduct the fees from the granter
+	if s.txBuilder(opts...).GetTx().FeeGranter() != nil {
+		gas += FeeGrantGasCost
+	}
+	return gas, nil
+}
+
+// withEstimatedGasAndFee returns the provided TxOptions along with the
+// options setting the estimated gas limit and the fee derived from the gas
+// price. The gas limit is only estimated if it isn't set by the provided
+// options and the fee is only set if it isn't set by them either.
+func (s *Signer) withEstimatedGasAndFee(opts []TxOption, estimate func() (uint64, error)) ([]TxOption, error) {
+	builtTx := s.txBuilder(opts...).GetTx()
+	if builtTx.GetGas() != 0 {
+		return opts, nil
+	}
+
+	gasLimit, err := estimate()
+	if err != nil {
+		return nil, fmt.Errorf("estimating gas: %w", err)
+	}
+
+	estimatedOpts := append(append(make([]TxOption, 0, len(opts)+2), opts...), SetGasLimit(gasLimit))
+	if builtTx.GetFee().IsZero() {
+		estimatedOpts = append(estimatedOpts, SetFee(uint64(math.Ceil(float64(gasLimit)*s.GasPrice()))))
+	}
+	return estimatedOpts, nil
+}
+
+// QueryMinGasPrice fetches the minimum gas price, in utia, accepted by the
+// celestia-app node.
+func QueryMinGasPrice(ctx context.Context, conn *grpc.ClientConn) (float64, error) {
+	resp, err := nodeservice.NewServiceClient(conn).Config(ctx, &nodeservice.ConfigRequest{})
+	if err != nil {
+		return 0, err
+	}
+
+	minGasPrices, err := sdktypes.ParseDecCoins(resp.MinimumGasPrice)
+	if err != nil {
+		return 0, fmt.Errorf("parsing minimum gas price %q: %w", resp.MinimumGasPrice, err)
+	}
+
+	return minGasPrices.AmountOf(appconsts.BondDenom).Float64()
+}
@@ -0,0 +1,376 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	apperrors "github.com/celestiaorg/celestia-app/app/errors"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultEvictionTimeout is the duration after which a broadcasted transaction
+// that hasn't been committed is considered evicted from the mempool. It exceeds
+// the time to live of transactions in the mempool of nodes using the default
+// config, which is 5 blocks.
+const DefaultEvictionTimeout = 6 * appconsts.GoalBlockTime
+
+// ErrPipelineClosed is returned when submitting a transaction to a pipeline
+// whose context has been cancelled.
+var ErrPipelineClosed = errors.New("pipeline closed")
+
+// TxResult is the outcome of a transaction submitted through a Pipeline.
+type TxResult struct {
+	TxResponse *sdktypes.TxResponse
+	Err        error
+}
+
+// Pipeline submits the transactions of a signer without waiting for the
+// previous ones to be committed. It keeps up to a maximum number of
+// transactions in flight, each signed with the sequence following the one of
+// the previous transaction. If the sequence of the signer gets out of sync
+// with the chain, or if an in flight transaction is evicted from the mempool,
+// the pipeline resyncs with the chain and re-signs and resubmits the
+// transactions that follow.
+//
+// A pipeline must be the only user of its signer while it is running.
+type Pipeline struct {
+	signer          *Signer
+	encCfg          encoding.Config
+	evictionTimeout time.Duration
+	// slots limits the number of transactions in flight.
+	slots chan struct{}
+	done  <-chan struct{}
+
+	mtx      sync.Mutex
+	inFlight []*pipelinedTx
+}
+
+// pipelinedTx is a transaction that has been broadcasted by a Pipeline and
+// hasn't been committed yet.
+type pipelinedTx struct {
+	msgs        []sdktypes.Msg
+	blobs       []*blob.Blob
+	opts        []TxOption
+	sequence    uint64
+	hash        string
+	broadcastAt time.Time
+	result      chan TxResult
+}
+
+// polledTx is a snapshot of a transaction in flight along with the response
+// of its query, which is nil if it hasn't been committed.
+type polledTx struct {
+	ptx  *pipelinedTx
+	hash string
+	resp *sdktypes.TxResponse
+}
+
+// NewPipeline returns a pipeline that keeps up to maxInFlight transactions of
+// the signer in flight. The pipeline polls for the confirmation of the
+// transactions until ctx is cancelled, at which point every transaction still
+// in flight is returned with the context's error.
+func NewPipeline(ctx context.Context, signer *Signer, encCfg encoding.Config, maxInFlight int) (*Pipeline, error) {
+	if maxInFlight < 1 {
+		return nil, fmt.Errorf("max in flight transactions must be at least 1, got %d", maxInFlight)
+	}
+	p := &Pipeline{
+		signer:          signer,
+		encCfg:          encCfg,
+		evictionTimeout: DefaultEvictionTimeout,
+		slots:           make(chan struct{}, maxInFlight),
+		done:            ctx.Done(),
+		inFlight:        make([]*pipelinedTx, 0, maxInFlight),
+	}
+	go p.run(ctx)
+	return p, nil
+}
+
+// SetEvictionTimeout sets the duration after which a broadcasted transaction
+// that hasn't been committed is considered evicted from the mempool.
+func (p *Pipeline) SetEvictionTimeout(timeout time.Duration) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.evictionTimeout = timeout
+}
+
+// SubmitTx forms a transaction from the provided messages, signs it with the
+// next sequence and broadcasts it. It blocks while the maximum number of
+// transactions are in flight and returns once the transaction has been
+// accepted by the mempool. The returned channel receives the result of the
+// transaction once it is committed. TxOptions may be provided to set the fee
+// and gas limit, which are otherwise estimated.
+func (p *Pipeline) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (<-chan TxResult, error) {
+	return p.submit(ctx, msgs, nil, opts, func(opts []TxOption) (uint64, error) {
+		return p.signer.EstimateGas(ctx, msgs, opts...)
+	})
+}
+
+// SubmitPayForBlob forms a transaction from the provided blobs, signs it with
+// the next sequence and broadcasts it. See SubmitTx.
+func (p *Pipeline) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (<-chan TxResult, error) {
+	msg, err := blobtypes.NewMsgPayForBlobs(p.signer.address.String(), blobs...)
+	if err != nil {
+		return nil, err
+	}
+	return p.submit(ctx, []sdktypes.Msg{msg}, blobs, opts, func(opts []TxOption) (uint64, error) {
+		return p.signer.EstimatePayForBlobGas(ctx, blobs, opts...)
+	})
+}
+
+// InFlight returns the number of transactions that have been broadcasted but
+// not committed yet.
+func (p *Pipeline) InFlight() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return len(p.inFlight)
+}
+
+func (p *Pipeline) submit(
+	ctx context.Context,
+	msgs []sdktypes.Msg,
+	blobs []*blob.Blob,
+	opts []TxOption,
+	estimate func([]TxOption) (uint64, error),
+) (<-chan TxResult, error) {
+	// wait for a transaction to be committed if too many are in flight
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-p.done:
+		return nil, ErrPipelineClosed
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	select {
+	case <-p.done:
+		<-p.slots
+		return nil, ErrPipelineClosed
+	default:
+	}
+
+	// the gas is estimated while holding the lock so that the simulation uses
+	// the sequence the transaction is signed with.
+	opts, err := p.signer.withEstimatedGasAndFee(opts, func() (uint64, error) { return estimate(opts) })
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+
+	ptx := &pipelinedTx{
+		msgs:   msgs,
+		blobs:  blobs,
+		opts:   opts,
+		result: make(chan TxResult, 1),
+	}
+	ptx.sequence = p.signer.GetSequence()
+	err = p.broadcast(ctx, ptx)
+	if apperrors.IsNonceMismatch(err) {
+		// the sequence of the signer is out of sync with the chain. Resync
+		// and resubmit the transactions in flight before submitting this one.
+		p.signer.ForceSetSequence(ptx.sequence)
+		if err := p.resync(ctx); err != nil {
+			<-p.slots
+			return nil, fmt.Errorf("resyncing sequence: %w", err)
+		}
+		ptx.sequence = p.signer.GetSequence()
+		err = p.broadcast(ctx, ptx)
+	}
+	if err != nil {
+		// the sequence was not used so it can be used by the next transaction
+		p.signer.ForceSetSequence(ptx.sequence)
+		<-p.slots
+		return nil, err
+	}
+
+	p.inFlight = append(p.inFlight, ptx)
+	return ptx.result, nil
+}
+
+// broadcast signs the transaction with its sequence and broadcasts it. The
+// transaction is considered broadcasted if it was already in the mempool.
+func (p *Pipeline) broadcast(ctx context.Context, ptx *pipelinedTx) error {
+	txBytes, err := p.signer.createTxWithSequence(ptx.msgs, ptx.blobs, ptx.sequence, ptx.opts...)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.signer.BroadcastTx(ctx, txBytes)
+	if err != nil {
+		return err
+	}
+	if resp.Code != 0 {
+		err := sdkerrors.ABCIError(resp.Codespace, resp.Code, resp.RawLog)
+		if !errors.Is(err, sdkerrors.ErrTxInMempoolCache) {
+			return err
+		}
+	}
+
+	ptx.hash = resp.TxHash
+	ptx.broadcastAt = time.Now()
+	return nil
+}
+
+// resync sets the sequence of the signer to the one of the account on chain
+// and resubmits every transaction in flight that hasn't been committed. The
+// transactions following one that can no longer be submitted are re-signed
+// with their new sequence. It must be called while holding the lock.
+func (p *Pipeline) resync(ctx context.Context) error {
+	_, sequence, err := QueryAccount(ctx, p.signer.grpc, p.encCfg, p.signer.address.String())
+	if err != nil {
+		return err
+	}
+
+	inFlight := make([]*pipelinedTx, 0, len(p.inFlight))
+	for _, ptx := range p.inFlight {
+		if ptx.sequence >= sequence {
+			inFlight = append(inFlight, ptx)
+			continue
+		}
+		// a transaction with this sequence has been committed. Unless it is
+		// this one, the transaction can never be committed.
+		resp, err := p.getTx(ctx, ptx.hash)
+		switch {
+		case err != nil:
+			return err
+		case resp != nil:
+			p.resolve(ptx, resp, nil)
+		default:
+			p.resolve(ptx, nil, fmt.Errorf("tx %s was evicted and sequence %d was used by another transaction", ptx.hash, ptx.sequence))
+		}
+	}
+
+	for _, ptx := range inFlight {
+		ptx.sequence = sequence
+		if err := p.broadcast(ctx, ptx); err != nil {
+			if apperrors.IsNonceMismatch(err) || isTransient(err) {
+				// keep the transactions in flight so that the resync can be
+				// retried.
+				p.inFlight = filterUnresolved(inFlight)
+				return err
+			}
+			// the transaction can't be resubmitted so the following ones
+			// are re-signed with its sequence.
+			p.resolve(ptx, nil, err)
+			continue
+		}
+		sequence++
+	}
+
+	p.inFlight = filterUnresolved(inFlight)
+	p.signer.ForceSetSequence(sequence)
+	return nil
+}
+
+// run polls for the confirmation of the transactions in flight until ctx is
+// cancelled.
+func (p *Pipeline) run(ctx context.Context) {
+	timer := time.NewTimer(p.signer.pollTime)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			p.mtx.Lock()
+			for _, ptx := range p.inFlight {
+				p.resolve(ptx, nil, ctx.Err())
+			}
+			p.inFlight = nil
+			p.mtx.Unlock()
+			return
+		case <-timer.C:
+			p.poll(ctx)
+			timer.Reset(p.signer.pollTime)
+		}
+	}
+}
+
+// poll checks whether the transactions in flight have been committed and
+// resyncs if the oldest one has been in flight for longer than the eviction
+// timeout. The transactions are queried without holding the lock so that
+// submissions aren't blocked by the queries.
+func (p *Pipeline) poll(ctx context.Context) {
+	p.mtx.Lock()
+	polled := make([]polledTx, len(p.inFlight))
+	for i, ptx := range p.inFlight {
+		polled[i] = polledTx{ptx: ptx, hash: ptx.hash}
+	}
+	p.mtx.Unlock()
+
+	for i := range polled {
+		// errors are ignored as the transaction is queried again on the next
+		// poll
+		polled[i].resp, _ = p.getTx(ctx, polled[i].hash)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, pt := range polled {
+		// the transaction may have been resolved or re-signed by a resync
+		// while it was queried, in which case the response is stale.
+		if pt.resp == nil || pt.ptx.hash != pt.hash {
+			continue
+		}
+		p.resolve(pt.ptx, pt.resp, nil)
+	}
+	p.inFlight = filterUnresolved(p.inFlight)
+
+	if len(p.inFlight) > 0 && time.Since(p.inFlight[0].broadcastAt) > p.evictionTimeout {
+		// errors are transient so the resync is retried on the next poll
+		_ = p.resync(ctx)
+	}
+}
+
+// getTx returns the response of the committed transaction with the provided
+// hash. It returns nil if the transaction hasn't been committed.
+func (p *Pipeline) getTx(ctx context.Context, hash string) (*sdktypes.TxResponse, error) {
+	resp, err := tx.NewServiceClient(p.signer.grpc).GetTx(ctx, &tx.GetTxRequest{Hash: hash})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.TxResponse, nil
+}
+
+// resolve sends the result of the transaction and frees its slot. Resolved
+// transactions have a nil result channel.
+func (p *Pipeline) resolve(ptx *pipelinedTx, resp *sdktypes.TxResponse, err error) {
+	if ptx.result == nil {
+		return
+	}
+	if err == nil && resp.Code != 0 {
+		err = fmt.Errorf("tx failed with code %d: %s", resp.Code, resp.RawLog)
+	}
+	ptx.result <- TxResult{TxResponse: resp, Err: err}
+	ptx.result = nil
+	<-p.slots
+}
+
+func filterUnresolved(txs []*pipelinedTx) []*pipelinedTx {
+	unresolved := make([]*pipelinedTx, 0, len(txs))
+	for _, ptx := range txs {
+		if ptx.result != nil {
+			unresolved = append(unresolved, ptx)
+		}
+	}
+	return unresolved
+}
+
+// isTransient returns true if the error was not returned by the node as the
+// result of checking the transaction, e.g. a connection error.
+func isTransient(err error) bool {
+	var abciErr interface{ ABCICode() uint32 }
+	return !errors.As(err, &abciErr)
+}
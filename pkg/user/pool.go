@@ -0,0 +1,322 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"google.golang.org/grpc"
+)
+
+// DispatchStrategy determines which signer of a SignerPool submits the next
+// transaction.
+type DispatchStrategy int
+
+const (
+	// RoundRobin dispatches transactions to each signer in turn.
+	RoundRobin DispatchStrategy = iota
+	// LeastLoaded dispatches transactions to the signer with the fewest
+	// transactions being submitted, in turn if several are equally loaded.
+	LeastLoaded
+)
+
+const (
+	// DefaultFeeAllowanceSpendLimit is the amount of utia that each account of
+	// a SignerPool can spend on fees by default.
+	DefaultFeeAllowanceSpendLimit = 1_000_000
+	// DefaultFeeAllowanceExpiration is the duration after which the fee
+	// allowance of each account of a SignerPool expires by default.
+	DefaultFeeAllowanceExpiration = 7 * 24 * time.Hour
+)
+
+// FeeAllowance is the basic fee allowance that the fee granter of a
+// SignerPool grants to each of its accounts.
+type FeeAllowance struct {
+	// SpendLimit is the maximum amount of fees that an account can spend. It
+	// is unlimited if empty.
+	SpendLimit sdktypes.Coins
+	// Expiration is the duration after which the allowance expires, starting
+	// when it is granted. It never expires if zero.
+	Expiration time.Duration
+}
+
+// DefaultFeeAllowance returns the fee allowance bounded by
+// DefaultFeeAllowanceSpendLimit and DefaultFeeAllowanceExpiration.
+func DefaultFeeAllowance() FeeAllowance {
+	return FeeAllowance{
+		SpendLimit: sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, DefaultFeeAllowanceSpendLimit)),
+		Expiration: DefaultFeeAllowanceExpiration,
+	}
+}
+
+// basicAllowance returns the basic allowance expiring after the expiration
+// duration from now.
+func (a FeeAllowance) basicAllowance() *feegrant.BasicAllowance {
+	allowance := &feegrant.BasicAllowance{SpendLimit: a.SpendLimit}
+	if a.Expiration > 0 {
+		expiration := time.Now().Add(a.Expiration)
+		allowance.Expiration = &expiration
+	}
+	return allowance
+}
+
+// SignerPool manages the signers of several accounts from a single keyring so
+// that transactions can be submitted in parallel without waiting on the
+// sequence of a single account. The fees of the transactions can optionally
+// be paid by a shared fee granter, in which case the pool provisions a fee
+// allowance for each of its accounts.
+type SignerPool struct {
+	keys   keyring.Keyring
+	conn   *grpc.ClientConn
+	encCfg encoding.Config
+
+	mtx        sync.Mutex
+	signers    []*Signer
+	load       []int
+	next       int
+	strategy   DispatchStrategy
+	feeGranter *Signer
+	allowance  FeeAllowance
+}
+
+// SetupSignerPool sets up a signer pool for the provided accounts of the
+// keyring, or for every account of the keyring if none are provided. The
+// accounts must exist on chain.
+func SetupSignerPool(
+	ctx context.Context,
+	keys keyring.Keyring,
+	conn *grpc.ClientConn,
+	encCfg encoding.Config,
+	addresses ...sdktypes.AccAddress,
+) (*SignerPool, error) {
+	p := &SignerPool{keys: keys, conn: conn, encCfg: encCfg}
+	if len(addresses) == 0 {
+		var err error
+		addresses, err = keyringAddresses(keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := p.AddAccounts(ctx, addresses...); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// SetupSignerPoolWithFeeGranter sets up a signer pool for the provided
+// accounts of the keyring, or for every other account of the keyring if none
+// are provided, whose fees are paid by feeGranter. The fee granter must be an
+// account of the keyring that exists on chain. It creates the accounts that
+// don't exist on chain and grants them the provided fee allowance if they
+// don't have one. See DefaultFeeAllowance.
+func SetupSignerPoolWithFeeGranter(
+	ctx context.Context,
+	keys keyring.Keyring,
+	conn *grpc.ClientConn,
+	encCfg encoding.Config,
+	feeGranter sdktypes.AccAddress,
+	allowance FeeAllowance,
+	addresses ...sdktypes.AccAddress,
+) (*SignerPool, error) {
+	if err := allowance.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fee allowance spend limit: %w", err)
+	}
+	if allowance.Expiration < 0 {
+		return nil, fmt.Errorf("fee allowance expiration cannot be negative, got %s", allowance.Expiration)
+	}
+	granter, err := SetupSigner(ctx, keys, conn, feeGranter, encCfg)
+	if err != nil {
+		return nil, fmt.Errorf("setting up fee granter: %w", err)
+	}
+	p := &SignerPool{keys: keys, conn: conn, encCfg: encCfg, feeGranter: granter, allowance: allowance}
+	if len(addresses) == 0 {
+		addresses, err = keyringAddresses(keys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	grantees := make([]sdktypes.AccAddress, 0, len(addresses))
+	for _, address := range addresses {
+		if !address.Equals(feeGranter) {
+			grantees = append(grantees, address)
+		}
+	}
+	if err := p.AddAccounts(ctx, grantees...); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// AddAccounts sets up a signer for each of the provided accounts of the
+// keyring and adds them to the pool. If the pool has a fee granter, the
+// accounts that don't exist on chain are created and the accounts that don't
+// have a fee allowance from the fee granter are granted one.
+func (p *SignerPool) AddAccounts(ctx context.Context, addresses ...sdktypes.AccAddress) error {
+	if len(addresses) == 0 {
+		return errors.New("at least one account is required")
+	}
+	if p.feeGranter != nil {
+		if err := p.provisionFeeGrants(ctx, addresses); err != nil {
+			return fmt.Errorf("provisioning fee grants: %w", err)
+		}
+	}
+
+	signers := make([]*Signer, len(addresses))
+	for i, address := range addresses {
+		signer, err := SetupSigner(ctx, p.keys, p.conn, address, p.encCfg)
+		if err != nil {
+			return fmt.Errorf("setting up signer for %s: %w", address, err)
+		}
+		signers[i] = signer
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.signers = append(p.signers, signers...)
+	p.load = append(p.load, make([]int, len(signers))...)
+	return nil
+}
+
+// provisionFeeGrants submits a single transaction from the fee granter that
+// creates the accounts that don't exist on chain, by sending them the
+// smallest amount possible, and grants the fee allowance of the pool to the
+// accounts that don't have one.
+func (p *SignerPool) provisionFeeGrants(ctx context.Context, addresses []sdktypes.AccAddress) error {
+	granter := p.feeGranter.Address()
+	feegrantClient := feegrant.NewQueryClient(p.conn)
+	msgs := make([]sdktypes.Msg, 0)
+	for _, address := range addresses {
+		if address.Equals(granter) {
+			return fmt.Errorf("fee granter %s cannot grant an allowance to itself", address)
+		}
+
+		if _, _, err := QueryAccount(ctx, p.conn, p.encCfg, address.String()); err != nil {
+			if !isNotFound(err) {
+				return err
+			}
+			msgs = append(msgs, banktypes.NewMsgSend(granter, address, sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, 1))))
+		}
+
+		_, err := feegrantClient.Allowance(ctx, &feegrant.QueryAllowanceRequest{
+			Granter: granter.String(),
+			Grantee: address.String(),
+		})
+		if err == nil {
+			continue
+		}
+		if !isNotFound(err) {
+			return err
+		}
+		grantMsg, err := feegrant.NewMsgGrantAllowance(p.allowance.basicAllowance(), granter, address)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, grantMsg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	_, err := p.feeGranter.SubmitTx(ctx, msgs)
+	return err
+}
+
+// SetDispatchStrategy sets the strategy used to pick the signer of the next
+// transaction. It defaults to RoundRobin.
+func (p *SignerPool) SetDispatchStrategy(strategy DispatchStrategy) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.strategy = strategy
+}
+
+// Signers returns the signers of the pool, excluding the fee granter.
+func (p *SignerPool) Signers() []*Signer {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return append([]*Signer{}, p.signers...)
+}
+
+// FeeGranter returns the signer of the fee granter of the pool or nil if the
+// pool doesn't have one.
+func (p *SignerPool) FeeGranter() *Signer {
+	return p.feeGranter
+}
+
+// SubmitPayForBlob submits a PayForBlobs transaction for the provided blobs
+// using the next signer of the pool and waits for it to be committed. The
+// fees are paid by the fee granter of the pool if it has one. TxOptions may be
+// provided to set the fee and gas limit, which are otherwise estimated.
+func (p *SignerPool) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	index, signer, err := p.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer p.release(index)
+
+	if p.feeGranter != nil {
+		opts = append(opts, SetFeeGranter(p.feeGranter.Address()))
+	}
+	return signer.SubmitPayForBlob(ctx, blobs, opts...)
+}
+
+// acquire picks the signer of the next transaction according to the dispatch
+// strategy and increases its load.
+func (p *SignerPool) acquire() (int, *Signer, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if len(p.signers) == 0 {
+		return 0, nil, errors.New("signer pool has no signers")
+	}
+
+	index := p.next % len(p.signers)
+	if p.strategy == LeastLoaded {
+		// iterate from the next signer so that equally loaded signers are
+		// picked in turn.
+		for i := 1; i < len(p.signers); i++ {
+			candidate := (p.next + i) % len(p.signers)
+			if p.load[candidate] < p.load[index] {
+				index = candidate
+			}
+		}
+	}
+	p.next = index + 1
+	p.load[index]++
+	return index, p.signers[index], nil
+}
+
+func (p *SignerPool) release(index int) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.load[index]--
+}
+
+func keyringAddresses(keys keyring.Keyring) ([]sdktypes.AccAddress, error) {
+	records, err := keys.List()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make([]sdktypes.AccAddress, len(records))
+	for i, record := range records {
+		addresses[i], err = record.GetAddress()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return addresses, nil
+}
+
+func isNotFound(err error) bool {
+	return strings.Contains(err.Error(), "not found")
+}
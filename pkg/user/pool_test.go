@@ -0,0 +1,161 @@
+package user_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/user"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestSignerPoolTestSuite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	suite.Run(t, new(SignerPoolTestSuite))
+}
+
+type SignerPoolTestSuite struct {
+	suite.Suite
+
+	ctx    testnode.Context
+	encCfg encoding.Config
+}
+
+func (s *SignerPoolTestSuite) SetupSuite() {
+	s.encCfg = encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	s.ctx, _, _ = testnode.NewNetwork(s.T(), testnode.DefaultConfig().WithFundedAccounts("a", "b", "granter"))
+	_, err := s.ctx.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *SignerPoolTestSuite) address(name string) sdk.AccAddress {
+	rec, err := s.ctx.Keyring.Key(name)
+	s.Require().NoError(err)
+	addr, err := rec.GetAddress()
+	s.Require().NoError(err)
+	return addr
+}
+
+func (s *SignerPoolTestSuite) sequence(address sdk.AccAddress) uint64 {
+	_, sequence, err := user.QueryAccount(s.ctx.GoContext(), s.ctx.GRPCClient, s.encCfg, address.String())
+	s.Require().NoError(err)
+	return sequence
+}
+
+func (s *SignerPoolTestSuite) TestRoundRobin() {
+	t := s.T()
+	a, b := s.address("a"), s.address("b")
+	pool, err := user.SetupSignerPool(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.encCfg, a, b)
+	require.NoError(t, err)
+	require.Len(t, pool.Signers(), 2)
+	require.Nil(t, pool.FeeGranter())
+
+	sequenceA, sequenceB := s.sequence(a), s.sequence(b)
+	for i := 0; i < 4; i++ {
+		resp, err := pool.SubmitPayForBlob(s.ctx.GoContext(), blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+	}
+	require.Equal(t, sequenceA+2, s.sequence(a))
+	require.Equal(t, sequenceB+2, s.sequence(b))
+}
+
+func (s *SignerPoolTestSuite) TestLeastLoaded() {
+	t := s.T()
+	a, b := s.address("a"), s.address("b")
+	pool, err := user.SetupSignerPool(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.encCfg, a, b)
+	require.NoError(t, err)
+	pool.SetDispatchStrategy(user.LeastLoaded)
+
+	sequenceA, sequenceB := s.sequence(a), s.sequence(b)
+	ctx, cancel := context.WithTimeout(s.ctx.GoContext(), time.Minute)
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := pool.SubmitPayForBlob(ctx, blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+			if assert.NoError(t, err) {
+				assert.EqualValues(t, 0, resp.Code)
+			}
+		}()
+	}
+	wg.Wait()
+	// the concurrent transactions are dispatched to different signers
+	require.Equal(t, sequenceA+1, s.sequence(a))
+	require.Equal(t, sequenceB+1, s.sequence(b))
+}
+
+func (s *SignerPoolTestSuite) TestFeeGranter() {
+	t := s.T()
+	granter := s.address("granter")
+	path := hd.CreateHDPath(sdk.CoinType, 0, 0).String()
+	grantees := make([]sdk.AccAddress, 2)
+	for i, name := range []string{"grantee-1", "grantee-2"} {
+		rec, _, err := s.ctx.Keyring.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		grantees[i], err = rec.GetAddress()
+		require.NoError(t, err)
+	}
+
+	allowance := user.DefaultFeeAllowance()
+	pool, err := user.SetupSignerPoolWithFeeGranter(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.encCfg, granter, allowance, grantees...)
+	require.NoError(t, err)
+	require.Len(t, pool.Signers(), 2)
+	require.Equal(t, granter, pool.FeeGranter().Address())
+
+	// the grantees are granted the bounded allowance
+	feegrantClient := feegrant.NewQueryClient(s.ctx.GRPCClient)
+	for _, grantee := range grantees {
+		resp, err := feegrantClient.Allowance(s.ctx.GoContext(), &feegrant.QueryAllowanceRequest{Granter: granter.String(), Grantee: grantee.String()})
+		require.NoError(t, err)
+		var granted feegrant.BasicAllowance
+		require.NoError(t, granted.Unmarshal(resp.Allowance.Allowance.Value))
+		require.Equal(t, allowance.SpendLimit, granted.SpendLimit)
+		require.NotNil(t, granted.Expiration)
+		require.WithinDuration(t, time.Now().Add(allowance.Expiration), *granted.Expiration, time.Minute)
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := pool.SubmitPayForBlob(s.ctx.GoContext(), blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+	}
+
+	// the fees were paid by the granter so the grantees kept the amount
+	// used to create their accounts.
+	bankClient := bank.NewQueryClient(s.ctx.GRPCClient)
+	for _, grantee := range grantees {
+		require.Equal(t, uint64(1), s.sequence(grantee))
+		balance, err := bankClient.Balance(s.ctx.GoContext(), &bank.QueryBalanceRequest{Address: grantee.String(), Denom: appconsts.BondDenom})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, balance.Balance.Amount.Int64())
+	}
+
+	// setting up the pool again doesn't provision the accounts again
+	granterSequence := s.sequence(granter)
+	_, err = user.SetupSignerPoolWithFeeGranter(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.encCfg, granter, allowance, grantees...)
+	require.NoError(t, err)
+	require.Equal(t, granterSequence, s.sequence(granter))
+
+	// the allowance must be valid
+	_, err = user.SetupSignerPoolWithFeeGranter(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.encCfg, granter, user.FeeAllowance{Expiration: -time.Hour}, grantees...)
+	require.Error(t, err)
+}
@@ -0,0 +1,326 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+
+	apperrors "github.com/celestiaorg/celestia-app/app/errors"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// sequenceMismatchRegexp matches the error of a transaction signed with a
+// sequence other than the expected one.
+var sequenceMismatchRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got (\d+)`)
+
+var (
+	// ErrTxEvicted is the reason of a transaction that was not committed
+	// within the eviction timeout of the signer and whose sequence is no
+	// longer used by the mempool of the node, in which case it is considered
+	// evicted from the mempool.
+	ErrTxEvicted = errors.New("tx evicted from the mempool")
+	// ErrTxTimeoutHeightExpired is the reason of a transaction that was not
+	// committed before its timeout height.
+	ErrTxTimeoutHeightExpired = errors.New("tx timeout height expired")
+)
+
+// TxNotCommittedError is returned when confirming a transaction submitted by
+// the signer that can no longer be committed. It wraps the reason why the
+// transaction was not committed, either ErrTxEvicted or
+// ErrTxTimeoutHeightExpired.
+type TxNotCommittedError struct {
+	// TxHash is the hash of the last submission of the transaction.
+	TxHash string
+	Reason error
+	// TimeoutHeight is the timeout height of the transaction, zero if it has
+	// none.
+	TimeoutHeight uint64
+	// LatestHeight is the latest height of the chain when the timeout height
+	// was found to have expired.
+	LatestHeight int64
+	// Resubmissions is the number of times the signer attempted to resubmit
+	// the transaction.
+	Resubmissions int
+	// ResubmitErr is the error that prevented the transaction from being
+	// resubmitted, if any.
+	ResubmitErr error
+}
+
+func (e *TxNotCommittedError) Error() string {
+	msg := fmt.Sprintf("tx %s was not committed: %v", e.TxHash, e.Reason)
+	if errors.Is(e.Reason, ErrTxTimeoutHeightExpired) {
+		msg += fmt.Sprintf(" (timeout height %d, latest height %d)", e.TimeoutHeight, e.LatestHeight)
+	}
+	if e.Resubmissions > 0 {
+		msg += fmt.Sprintf(" after %d resubmissions", e.Resubmissions)
+	}
+	if e.ResubmitErr != nil {
+		msg += fmt.Sprintf(": resubmitting: %v", e.ResubmitErr)
+	}
+	return msg
+}
+
+func (e *TxNotCommittedError) Unwrap() error {
+	return e.Reason
+}
+
+// ResubmitPolicy determines how the signer resubmits the transactions that
+// can no longer be committed. The resubmitted transaction is signed with the
+// same sequence and a higher fee.
+type ResubmitPolicy struct {
+	// MaxResubmissions is the maximum number of times a transaction is
+	// resubmitted.
+	MaxResubmissions int
+	// FeeMultiplier is applied to the fee of a transaction each time it is
+	// resubmitted. It must be greater than 1.
+	FeeMultiplier float64
+	// MaxFee is the maximum fee, in utia, of a resubmitted transaction.
+	MaxFee uint64
+	// TimeoutHeightDelta is the number of blocks after the latest height at
+	// which a resubmitted transaction whose timeout height expired times out.
+	// If zero, the resubmitted transaction has no timeout height.
+	TimeoutHeightDelta uint64
+}
+
+// ValidateBasic checks that the policy is valid.
+func (p ResubmitPolicy) ValidateBasic() error {
+	if p.MaxResubmissions < 1 {
+		return fmt.Errorf("max resubmissions must be at least 1, got %d", p.MaxResubmissions)
+	}
+	if p.FeeMultiplier <= 1 {
+		return fmt.Errorf("fee multiplier must be greater than 1, got %v", p.FeeMultiplier)
+	}
+	if p.MaxFee == 0 {
+		return errors.New("max fee must be positive")
+	}
+	return nil
+}
+
+// bumpFee returns the fee of a resubmitted transaction whose fee was fee. It
+// returns false if the fee can't be increased without exceeding the max fee.
+func (p ResubmitPolicy) bumpFee(fee uint64) (uint64, bool) {
+	if fee >= p.MaxFee {
+		return fee, false
+	}
+	bumped := uint64(math.Ceil(float64(fee) * p.FeeMultiplier))
+	if bumped <= fee {
+		bumped = fee + 1
+	}
+	if bumped > p.MaxFee {
+		bumped = p.MaxFee
+	}
+	return bumped, true
+}
+
+// SetResubmitPolicy enables the resubmission of the transactions submitted by
+// the signer that can no longer be committed. Resubmission is disabled by
+// default.
+func (s *Signer) SetResubmitPolicy(policy ResubmitPolicy) error {
+	if err := policy.ValidateBasic(); err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.resubmitPolicy = &policy
+	return nil
+}
+
+// DisableResubmission disables the resubmission of transactions.
+func (s *Signer) DisableResubmission() {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.resubmitPolicy = nil
+}
+
+// SetEvictionTimeout sets the duration after which the signer checks whether a
+// transaction it submitted that hasn't been committed was evicted from the
+// mempool.
+func (s *Signer) SetEvictionTimeout(timeout time.Duration) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.evictionTimeout = timeout
+}
+
+// submittedTx is a transaction broadcasted by the signer that is being
+// confirmed.
+type submittedTx struct {
+	msgs          []sdktypes.Msg
+	blobs         []*blob.Blob
+	opts          []TxOption
+	sequence      uint64
+	fee           uint64
+	timeoutHeight uint64
+	broadcastAt   time.Time
+	resubmissions int
+}
+
+func (s *Signer) track(hash string, stx *submittedTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.submitted[hash] = stx
+}
+
+func (s *Signer) untrack(hash string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	delete(s.submitted, hash)
+}
+
+func (s *Signer) tracked(hash string) *submittedTx {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.submitted[hash]
+}
+
+// checkNotCommitted checks whether a tracked transaction that hasn't been
+// found on chain can still be committed. If it can't, it is resubmitted
+// according to the resubmit policy and the hash of the resubmitted transaction
+// is returned, otherwise the sequence of the transaction is released and a
+// *TxNotCommittedError is returned. Errors querying the chain are ignored so
+// that the check is retried on the next poll.
+func (s *Signer) checkNotCommitted(ctx context.Context, hash string, stx *submittedTx) (string, error) {
+	notCommitted := &TxNotCommittedError{
+		TxHash:        hash,
+		TimeoutHeight: stx.timeoutHeight,
+		Resubmissions: stx.resubmissions,
+	}
+	if stx.timeoutHeight != 0 {
+		resp, err := tmservice.NewServiceClient(s.grpc).GetLatestBlock(ctx, &tmservice.GetLatestBlockRequest{})
+		if err != nil {
+			return hash, nil
+		}
+		notCommitted.LatestHeight = resp.SdkBlock.Header.Height
+		if uint64(notCommitted.LatestHeight) > stx.timeoutHeight {
+			notCommitted.Reason = ErrTxTimeoutHeightExpired
+		}
+	}
+
+	s.mtx.RLock()
+	evictionTimeout, policy := s.evictionTimeout, s.resubmitPolicy
+	s.mtx.RUnlock()
+	if notCommitted.Reason == nil {
+		if time.Since(stx.broadcastAt) <= evictionTimeout {
+			return hash, nil
+		}
+		// the transaction is only evicted if the mempool no longer uses its
+		// sequence, otherwise it is checked again after another timeout.
+		sequence, err := s.nextSequence(ctx, stx)
+		if err != nil {
+			return hash, nil
+		}
+		if sequence > stx.sequence {
+			stx.broadcastAt = time.Now()
+			return hash, nil
+		}
+		notCommitted.Reason = ErrTxEvicted
+	}
+
+	if policy == nil || stx.resubmissions >= policy.MaxResubmissions {
+		s.releaseSequence(stx.sequence)
+		return "", notCommitted
+	}
+	fee, ok := policy.bumpFee(stx.fee)
+	if !ok {
+		notCommitted.ResubmitErr = fmt.Errorf("fee %dutia has reached the max fee", stx.fee)
+		s.releaseSequence(stx.sequence)
+		return "", notCommitted
+	}
+
+	resubmitted := &submittedTx{
+		msgs:          stx.msgs,
+		blobs:         stx.blobs,
+		opts:          append(append(make([]TxOption, 0, len(stx.opts)+2), stx.opts...), SetFee(fee)),
+		sequence:      stx.sequence,
+		fee:           fee,
+		timeoutHeight: stx.timeoutHeight,
+		resubmissions: stx.resubmissions + 1,
+	}
+	if errors.Is(notCommitted.Reason, ErrTxTimeoutHeightExpired) {
+		resubmitted.timeoutHeight = 0
+		if policy.TimeoutHeightDelta != 0 {
+			resubmitted.timeoutHeight = uint64(notCommitted.LatestHeight) + policy.TimeoutHeightDelta
+		}
+		resubmitted.opts = append(resubmitted.opts, SetTimeoutHeight(resubmitted.timeoutHeight))
+	}
+
+	resp, err := s.resubmit(ctx, resubmitted)
+	if err != nil {
+		if errors.Is(notCommitted.Reason, ErrTxEvicted) && apperrors.IsNonceMismatch(err) {
+			if expected, parseErr := apperrors.ParseNonceMismatch(err); parseErr == nil && expected > stx.sequence {
+				// the sequence was used in the meantime, most likely
+				// because the transaction was added back to the mempool, so
+				// keep waiting for it. Nothing was resubmitted so it doesn't
+				// count as a resubmission.
+				stx.broadcastAt = time.Now()
+				return hash, nil
+			}
+		}
+		notCommitted.Resubmissions++
+		notCommitted.ResubmitErr = err
+		s.releaseSequence(stx.sequence)
+		return "", notCommitted
+	}
+
+	s.untrack(hash)
+	s.track(resp.TxHash, resubmitted)
+	return resp.TxHash, nil
+}
+
+// nextSequence returns the sequence the node expects for the next transaction
+// of the signer, which accounts for the transactions in its mempool. It is
+// found by simulating the transaction with its own sequence.
+func (s *Signer) nextSequence(ctx context.Context, stx *submittedTx) (uint64, error) {
+	txBytes, err := s.createTxWithSequence(stx.msgs, nil, stx.sequence, stx.opts...)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.NewServiceClient(s.grpc).Simulate(ctx, &tx.SimulateRequest{TxBytes: txBytes})
+	if err == nil {
+		return stx.sequence, nil
+	}
+	// the error of the simulation is returned as a gRPC status so it is
+	// matched by its message.
+	matches := sequenceMismatchRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return 0, err
+	}
+	return strconv.ParseUint(matches[1], 10, 64)
+}
+
+// releaseSequence rolls the sequence of the signer back to the sequence of a
+// transaction that won't be committed so that the next transaction uses it.
+// The transactions signed after it can't be committed either as they would
+// skip the sequence.
+func (s *Signer) releaseSequence(sequence uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if s.lastSignedSequence > sequence {
+		s.lastSignedSequence = sequence
+	}
+}
+
+// resubmit signs the transaction with its sequence and broadcasts it.
+func (s *Signer) resubmit(ctx context.Context, stx *submittedTx) (*sdktypes.TxResponse, error) {
+	txBytes, err := s.createTxWithSequence(stx.msgs, stx.blobs, stx.sequence, stx.opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.BroadcastTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 0 {
+		return nil, sdkerrors.ABCIError(resp.Codespace, resp.Code, resp.RawLog)
+	}
+	stx.broadcastAt = time.Now()
+	return resp, nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/blob"
 	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,10 +36,17 @@ type Signer struct {
 	chainID       string
 	accountNumber uint64
 	pollTime      time.Duration
+	gasPrice      float64
+	gasMultiplier float64
 
 	mtx                   sync.RWMutex
 	lastSignedSequence    uint64
 	lastConfirmedSequence uint64
+	evictionTimeout       time.Duration
+	resubmitPolicy        *ResubmitPolicy
+	// submitted tracks the transactions submitted by the signer that are
+	// being confirmed, keyed by hash.
+	submitted map[string]*submittedTx
 }
 
 // NewSigner returns a new signer using the provided keyring
@@ -73,6 +81,10 @@ func NewSigner(
 		lastSignedSequence:    sequence,
 		lastConfirmedSequence: sequence,
 		pollTime:              DefaultPollTime,
+		gasPrice:              appconsts.DefaultMinGasPrice,
+		gasMultiplier:         DefaultGasMultiplier,
+		evictionTimeout:       DefaultEvictionTimeout,
+		submitted:             make(map[string]*submittedTx),
 	}, nil
 }
 
@@ -99,7 +111,8 @@ func SetupSingleSigner(ctx context.Context, keys keyring.Keyring, conn *grpc.Cli
 }
 
 // SetupSigner uses the underlying grpc connection to populate the chainID, accountNumber and sequence number of the
-// account.
+// account. The gas price of the signer is set to the minimum gas price of the node, unless the node doesn't set one in
+// which case it is appconsts.DefaultMinGasPrice.
 func SetupSigner(
 	ctx context.Context,
 	keys keyring.Keyring,
@@ -118,32 +131,72 @@ func SetupSigner(
 		return nil, err
 	}
 
-	return NewSigner(keys, conn, address, encCfg.TxConfig, chainID, accNum, seqNum)
+	minGasPrice, err := QueryMinGasPrice(ctx, conn)
+	if err != nil {
+		return nil, fmt.Errorf("querying minimum gas price: %w", err)
+	}
+
+	signer, err := NewSigner(keys, conn, address, encCfg.TxConfig, chainID, accNum, seqNum)
+	if err != nil {
+		return nil, err
+	}
+	if minGasPrice > 0 {
+		signer.SetGasPrice(minGasPrice)
+	}
+	return signer, nil
 }
 
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
-// may be provided to set the fee and gas limit.
+// may be provided to set the fee and gas limit. If the gas limit is not set, it is estimated by simulating
+// the transaction and the fee is derived from the signer's gas price.
 func (s *Signer) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
-	txBytes, err := s.CreateTx(msgs, opts...)
+	opts, err := s.withEstimatedGasAndFee(opts, func() (uint64, error) {
+		return s.EstimateGas(ctx, msgs, opts...)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := s.BroadcastTx(ctx, txBytes)
+	return s.submit(ctx, msgs, nil, opts)
+}
+
+// SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
+// TxOptions may be provided to set the fee and gas limit. If the gas limit is not set, it is estimated
+// using the on-chain gas parameters and the fee is derived from the signer's gas price.
+func (s *Signer) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	opts, err := s.withEstimatedGasAndFee(opts, func() (uint64, error) {
+		return s.EstimatePayForBlobGas(ctx, blobs, opts...)
+	})
 	if err != nil {
 		return nil, err
 	}
-	if resp.Code != 0 {
-		return resp, fmt.Errorf("tx failed with code %d: %s", resp.Code, resp.RawLog)
+
+	msg, err := blobtypes.NewMsgPayForBlobs(s.address.String(), blobs...)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.ConfirmTx(ctx, resp.TxHash)
+	return s.submit(ctx, []sdktypes.Msg{msg}, blobs, opts)
 }
 
-// SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
-// TxOptions may be provided to set the fee and gas limit.
-func (s *Signer) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
-	txBytes, err := s.CreatePayForBlob(blobs, opts...)
+// submit signs a transaction with the next sequence and broadcasts it. The
+// transaction is tracked while it is being confirmed so that it can be
+// resubmitted if it is not committed.
+func (s *Signer) submit(ctx context.Context, msgs []sdktypes.Msg, blobs []*blob.Blob, opts []TxOption) (*sdktypes.TxResponse, error) {
+	txBuilder, err := s.buildTx(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	stx := &submittedTx{
+		msgs:          msgs,
+		blobs:         blobs,
+		opts:          opts,
+		fee:           txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom).Uint64(),
+		timeoutHeight: txBuilder.GetTx().GetTimeoutHeight(),
+		sequence:      s.GetSequence(),
+	}
+	txBytes, err := s.encodeTx(txBuilder, blobs, stx.sequence)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +209,8 @@ func (s *Signer) SubmitPayForBlob(ctx context.Context, blobs []*blob.Blob, opts
 		return resp, fmt.Errorf("tx failed with code %d: %s", resp.Code, resp.RawLog)
 	}
 
+	stx.broadcastAt = time.Now()
+	s.track(resp.TxHash, stx)
 	return s.ConfirmTx(ctx, resp.TxHash)
 }
 
@@ -174,6 +229,51 @@ func (s *Signer) CreateTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, error)
 	return s.enc.TxEncoder()(txBuilder.GetTx())
 }
 
+// createTxWithSequence forms a transaction from the provided messages and
+// blobs, if any, and signs it using the provided sequence without updating
+// the local sequence number.
+func (s *Signer) createTxWithSequence(msgs []sdktypes.Msg, blobs []*blob.Blob, sequence uint64, opts ...TxOption) ([]byte, error) {
+	txBuilder, err := s.buildTx(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.encodeTx(txBuilder, blobs, sequence)
+}
+
+// buildTx forms an unsigned transaction from the provided messages and checks
+// that the signer is its only signer.
+func (s *Signer) buildTx(msgs []sdktypes.Msg, opts ...TxOption) (client.TxBuilder, error) {
+	txBuilder := s.txBuilder(opts...)
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSigner(txBuilder); err != nil {
+		return nil, err
+	}
+
+	return txBuilder, nil
+}
+
+// encodeTx signs the transaction using the provided sequence and encodes it,
+// wrapping it in a BlobTx if there are blobs.
+func (s *Signer) encodeTx(txBuilder client.TxBuilder, blobs []*blob.Blob, sequence uint64) ([]byte, error) {
+	if err := s.signTransactionWithSequence(txBuilder, sequence); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := s.enc.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) == 0 {
+		return txBytes, nil
+	}
+
+	return blob.MarshalBlobTx(txBytes, blobs...)
+}
+
 func (s *Signer) CreatePayForBlob(blobs []*blob.Blob, opts ...TxOption) ([]byte, error) {
 	msg, err := blobtypes.NewMsgPayForBlobs(s.address.String(), blobs...)
 	if err != nil {
@@ -208,11 +308,14 @@ func (s *Signer) BroadcastTx(ctx context.Context, txBytes []byte) (*sdktypes.TxR
 
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
 // hash. It will continually loop until the context is cancelled, the tx is found or an error
-// is encountered.
+// is encountered. If the transaction was submitted by the signer and can no longer be committed,
+// because its timeout height has passed or it was evicted from the mempool, it is resubmitted
+// according to the resubmit policy of the signer or a *TxNotCommittedError is returned.
 func (s *Signer) ConfirmTx(ctx context.Context, txHash string) (*sdktypes.TxResponse, error) {
 	txClient := tx.NewServiceClient(s.grpc)
 	timer := time.NewTimer(0)
 	defer timer.Stop()
+	defer func() { s.untrack(txHash) }()
 	for {
 		select {
 		case <-ctx.Done():
@@ -235,6 +338,13 @@ func (s *Signer) ConfirmTx(ctx context.Context, txHash string) (*sdktypes.TxResp
 				return &sdktypes.TxResponse{}, err
 			}
 
+			if stx := s.tracked(txHash); stx != nil {
+				txHash, err = s.checkNotCommitted(ctx, txHash, stx)
+				if err != nil {
+					return &sdktypes.TxResponse{}, err
+				}
+			}
+
 			timer.Reset(s.pollTime)
 		}
 	}
@@ -285,6 +395,15 @@ func (s *Signer) ForceSetSequence(seq uint64) {
 }
 
 func (s *Signer) signTransaction(builder client.TxBuilder) error {
+	if err := s.checkSigner(builder); err != nil {
+		return err
+	}
+
+	return s.signTransactionWithSequence(builder, s.GetSequence())
+}
+
+// checkSigner checks that the signer is the only signer of the transaction.
+func (s *Signer) checkSigner(builder client.TxBuilder) error {
 	signers := builder.GetTx().GetSigners()
 	if len(signers) != 1 {
 		return fmt.Errorf("expected 1 signer, got %d", len(signers))
@@ -294,8 +413,12 @@ func (s *Signer) signTransaction(builder client.TxBuilder) error {
 		return fmt.Errorf("expected signer %s, got %s", s.address.String(), signers[0].String())
 	}
 
-	sequence := s.GetSequence()
+	return nil
+}
 
+// signTransactionWithSequence signs the transaction using the provided
+// sequence without updating the local sequence number.
+func (s *Signer) signTransactionWithSequence(builder client.TxBuilder, sequence uint64) error {
 	// To ensure we have the correct bytes to sign over we produce
 	// a dry run of the signing data
 	draftsigV2 := signing.SignatureV2{
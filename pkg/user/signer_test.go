@@ -7,10 +7,13 @@ import (
 
 	"github.com/celestiaorg/celestia-app/app"
 	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/pkg/user"
 	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/x/blob/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -67,6 +70,104 @@ func (s *SignerTestSuite) TestSubmitTx() {
 	require.EqualValues(t, 0, resp.Code)
 }
 
+func (s *SignerTestSuite) TestSubmitPayForBlobWithEstimatedGas() {
+	t := s.T()
+	blobs := blobfactory.ManyRandBlobs(rand.NewRand(), 1e3, 1e4)
+	gas, err := s.signer.EstimatePayForBlobGas(s.ctx.GoContext(), blobs)
+	require.NoError(t, err)
+	require.Greater(t, gas, blobtypes.GasToConsume([]uint32{1e3, 1e4}, appconsts.DefaultGasPerBlobByte))
+
+	subCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	resp, err := s.signer.SubmitPayForBlob(subCtx, blobs)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.EqualValues(t, gas, resp.GasWanted)
+	require.LessOrEqual(t, resp.GasUsed, resp.GasWanted)
+}
+
+func (s *SignerTestSuite) TestSubmitTxWithEstimatedGas() {
+	t := s.T()
+	msg := bank.NewMsgSend(s.signer.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	gas, err := s.signer.EstimateGas(s.ctx.GoContext(), []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Greater(t, gas, uint64(0))
+
+	resp, err := s.signer.SubmitTx(s.ctx.GoContext(), []sdk.Msg{msg})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.LessOrEqual(t, resp.GasUsed, resp.GasWanted)
+}
+
+func (s *SignerTestSuite) TestQueryMinGasPrice() {
+	t := s.T()
+	minGasPrice, err := user.QueryMinGasPrice(s.ctx.GoContext(), s.ctx.GRPCClient)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, minGasPrice, float64(0))
+
+	// the signer uses the minimum gas price of the node unless it has none
+	signer, err := user.SetupSigner(s.ctx.GoContext(), s.ctx.Keyring, s.ctx.GRPCClient, s.signer.Address(), s.encCfg)
+	require.NoError(t, err)
+	if minGasPrice > 0 {
+		require.Equal(t, minGasPrice, signer.GasPrice())
+	} else {
+		require.Equal(t, appconsts.DefaultMinGasPrice, signer.GasPrice())
+	}
+}
+
+func (s *SignerTestSuite) TestPipeline() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(s.ctx.GoContext(), time.Minute)
+	defer cancel()
+	pipeline, err := user.NewPipeline(ctx, s.signer, s.encCfg, 3)
+	require.NoError(t, err)
+	// resubmitting transactions that are still in the mempool must not affect
+	// them.
+	pipeline.SetEvictionTimeout(time.Nanosecond)
+
+	results := make([]<-chan user.TxResult, 0)
+	for i := 0; i < 6; i++ {
+		msg := bank.NewMsgSend(s.signer.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+		result, err := pipeline.SubmitTx(ctx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+		require.NoError(t, err)
+		require.LessOrEqual(t, pipeline.InFlight(), 3)
+		results = append(results, result)
+	}
+	blobResult, err := pipeline.SubmitPayForBlob(ctx, blobfactory.ManyRandBlobs(rand.NewRand(), 1e3))
+	require.NoError(t, err)
+	results = append(results, blobResult)
+
+	for _, result := range results {
+		res := <-result
+		require.NoError(t, res.Err)
+		require.EqualValues(t, 0, res.TxResponse.Code)
+	}
+	require.Zero(t, pipeline.InFlight())
+}
+
+func (s *SignerTestSuite) TestPipelineResyncsSequence() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(s.ctx.GoContext(), time.Minute)
+	defer cancel()
+	pipeline, err := user.NewPipeline(ctx, s.signer, s.encCfg, 3)
+	require.NoError(t, err)
+
+	// put the signer's sequence ahead of the one of the account on chain
+	_, sequence, err := user.QueryAccount(ctx, s.ctx.GRPCClient, s.encCfg, s.signer.Address().String())
+	require.NoError(t, err)
+	s.signer.ForceSetSequence(sequence + 5)
+
+	msg := bank.NewMsgSend(s.signer.Address(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+	result, err := pipeline.SubmitTx(ctx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e6))
+	require.NoError(t, err)
+	res := <-result
+	require.NoError(t, res.Err)
+	require.EqualValues(t, 0, res.TxResponse.Code)
+	_, onChainSequence, err := user.QueryAccount(ctx, s.ctx.GRPCClient, s.encCfg, s.signer.Address().String())
+	require.NoError(t, err)
+	require.Equal(t, sequence+1, onChainSequence)
+}
+
 func (s *SignerTestSuite) ConfirmTxTimeout() {
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
@@ -74,3 +175,81 @@ func (s *SignerTestSuite) ConfirmTxTimeout() {
 	require.Error(s.T(), err)
 	require.Equal(s.T(), err, context.DeadlineExceeded)
 }
+
+func TestResubmission(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	// blocks are slow enough for a transaction to be checked against the
+	// latest height before the next block is produced.
+	ctx, _, _ := testnode.NewNetwork(t, testnode.DefaultConfig().WithFundedAccounts("a").WithTimeoutCommit(time.Second))
+	_, err := ctx.WaitForHeight(1)
+	require.NoError(t, err)
+	rec, err := ctx.Keyring.Key("a")
+	require.NoError(t, err)
+	addr, err := rec.GetAddress()
+	require.NoError(t, err)
+	signer, err := user.SetupSigner(ctx.GoContext(), ctx.Keyring, ctx.GRPCClient, addr, encCfg)
+	require.NoError(t, err)
+	signer.SetPollTime(100 * time.Millisecond)
+
+	latestHeight := func() uint64 {
+		height, err := ctx.LatestHeight()
+		require.NoError(t, err)
+		return uint64(height)
+	}
+	getTx := func(hash string) *tx.GetTxResponse {
+		resp, err := tx.NewServiceClient(ctx.GRPCClient).GetTx(ctx.GoContext(), &tx.GetTxRequest{Hash: hash})
+		require.NoError(t, err)
+		return resp
+	}
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 10)))
+
+	t.Run("eviction", func(t *testing.T) {
+		// every transaction that isn't found is checked for eviction
+		signer.SetEvictionTimeout(time.Nanosecond)
+		defer signer.SetEvictionTimeout(user.DefaultEvictionTimeout)
+
+		// the transaction is still in the mempool so it isn't considered
+		// evicted.
+		resp, err := signer.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e5), user.SetGasLimit(1e5))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+
+		// nor is it resubmitted with a higher fee, the original one is
+		// committed.
+		require.NoError(t, signer.SetResubmitPolicy(user.ResubmitPolicy{MaxResubmissions: 100, FeeMultiplier: 1.5, MaxFee: 1e6}))
+		defer signer.DisableResubmission()
+		resp, err = signer.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e5), user.SetGasLimit(1e5))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 1e5)), getTx(resp.TxHash).Tx.AuthInfo.Fee.Amount)
+	})
+
+	t.Run("timeout height expired", func(t *testing.T) {
+		// the transaction passes CheckTx at the latest height but can't be
+		// included in the next block.
+		_, err := signer.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e5), user.SetGasLimit(1e5), user.SetTimeoutHeight(latestHeight()))
+		var notCommitted *user.TxNotCommittedError
+		require.ErrorAs(t, err, &notCommitted)
+		require.ErrorIs(t, err, user.ErrTxTimeoutHeightExpired)
+		require.Greater(t, uint64(notCommitted.LatestHeight), notCommitted.TimeoutHeight)
+		require.Zero(t, notCommitted.Resubmissions)
+
+		// the signer released the sequence of the transaction so the next
+		// one is signed with it.
+		require.Error(t, signer.SetResubmitPolicy(user.ResubmitPolicy{MaxResubmissions: 1, FeeMultiplier: 1, MaxFee: 1e6}))
+		require.NoError(t, signer.SetResubmitPolicy(user.ResubmitPolicy{MaxResubmissions: 1, FeeMultiplier: 1.5, MaxFee: 1e6}))
+		defer signer.DisableResubmission()
+		resp, err := signer.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, user.SetFee(1e5), user.SetGasLimit(1e5), user.SetTimeoutHeight(latestHeight()))
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+
+		// the transaction was resubmitted without a timeout height and a
+		// higher fee
+		committed := getTx(resp.TxHash).Tx
+		require.Zero(t, committed.Body.TimeoutHeight)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(app.BondDenom, 15e4)), committed.AuthInfo.Fee.Amount)
+	})
+}
@@ -46,6 +46,7 @@ type Tree interface {
 	Root() ([]byte, error)
 	Push(namespacedData namespace.PrefixedData) error
 	ProveRange(start, end int) (nmt.Proof, error)
+	ProveNamespace(nID namespace.ID) (nmt.Proof, error)
 }
 
 // NewErasuredNamespacedMerkleTree creates a new ErasuredNamespacedMerkleTree
@@ -128,6 +129,14 @@ func (w *ErasuredNamespacedMerkleTree) ProveRange(start, end int) (nmt.Proof, er
 	return w.tree.ProveRange(start, end)
 }
 
+// ProveNamespace returns a namespace proof for the provided namespace. The
+// proof is an inclusion proof of all the leaves of the namespace if the tree
+// contains any, an absence proof if the namespace is within the namespace
+// range of the tree and an empty proof otherwise.
+func (w *ErasuredNamespacedMerkleTree) ProveNamespace(ns namespace.ID) (nmt.Proof, error) {
+	return w.tree.ProveNamespace(ns)
+}
+
 // incrementShareIndex increments the share index by one.
 func (w *ErasuredNamespacedMerkleTree) incrementShareIndex() {
 	w.shareIndex++
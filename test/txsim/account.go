@@ -223,21 +223,28 @@ func (am *AccountManager) Submit(ctx context.Context, op Operation) error {
 	}
 
 	opts := make([]user.TxOption, 0)
-	if op.GasLimit == 0 {
+	if am.useFeegrant {
+		opts = append(opts, user.SetFeeGranter(am.master.Address()))
+	}
+
+	gasLimit := op.GasLimit
+	if gasLimit == 0 && len(op.Blobs) > 0 {
+		gasLimit, err = signer.EstimatePayForBlobGas(ctx, op.Blobs, opts...)
+		if err != nil {
+			return fmt.Errorf("estimating gas: %w", err)
+		}
+	}
+	if gasLimit == 0 {
 		opts = append(opts, user.SetGasLimit(DefaultGasLimit), user.SetFee(defaultFee))
 	} else {
-		opts = append(opts, user.SetGasLimit(op.GasLimit))
+		opts = append(opts, user.SetGasLimit(gasLimit))
 		if op.GasPrice > 0 {
-			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(op.GasLimit)*op.GasPrice))))
+			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(gasLimit)*op.GasPrice))))
 		} else {
-			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(op.GasLimit)*appconsts.DefaultMinGasPrice))))
+			opts = append(opts, user.SetFee(uint64(math.Ceil(float64(gasLimit)*appconsts.DefaultMinGasPrice))))
 		}
 	}
 
-	if am.useFeegrant {
-		opts = append(opts, user.SetFeeGranter(am.master.Address()))
-	}
-
 	var res *types.TxResponse
 	if len(op.Blobs) > 0 {
 		res, err = signer.SubmitPayForBlob(ctx, op.Blobs, opts...)
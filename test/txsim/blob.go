@@ -88,10 +88,11 @@ func (s *BlobSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Ran
 	if err != nil {
 		return Operation{}, err
 	}
+	// the gas limit is left unset so that it is estimated when the operation
+	// is submitted.
 	return Operation{
-		Msgs:     []types.Msg{msg},
-		Blobs:    blobs,
-		GasLimit: estimateGas(sizes, s.useFeegrant),
+		Msgs:  []types.Msg{msg},
+		Blobs: blobs,
 	}, nil
 }
 
@@ -111,19 +112,3 @@ func (r Range) Rand(rand *rand.Rand) int {
 	}
 	return rand.Intn(r.Max-r.Min) + r.Min
 }
-
-// estimateGas estimates the gas required to pay for a set of blobs in a PFB.
-func estimateGas(blobSizes []int, useFeegrant bool) uint64 {
-	size := make([]uint32, len(blobSizes))
-	for i, s := range blobSizes {
-		size[i] = uint32(s)
-	}
-
-	// account for the extra gas required to pay for the fee granter
-	extra := uint64(0)
-	if useFeegrant {
-		extra = 12000
-	}
-
-	return blob.DefaultEstimateGas(size) + extra
-}
@@ -32,7 +32,8 @@ type Sequence interface {
 
 // Operation represents a series of messages and blobs that are to be bundled
 // in a single transaction. A delay (in heights) may also be set before the transaction is sent.
-// The gas limit and price can also be set. If left at 0, the DefaultGasLimit will be used.
+// The gas limit and price can also be set. If the gas limit is left at 0, it is
+// estimated for operations with blobs and the DefaultGasLimit is used otherwise.
 type Operation struct {
 	Msgs     []types.Msg
 	Blobs    []*blob.Blob
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,9 +51,14 @@ func (ao EmptyAppOptions) Get(_ string) interface{} {
 // is bonded with a delegation of one consensus engine unit in the default token
 // of the app from first genesis account. A no-op logger is set in app.
 func SetupTestAppWithGenesisValSet(cparams *tmproto.ConsensusParams, genAccounts ...string) (*app.App, keyring.Keyring) {
-	// var cache sdk.MultiStorePersistentCache
 	// EmptyAppOptions is a stub implementing AppOptions
-	emptyOpts := EmptyAppOptions{}
+	return SetupTestAppWithGenesisValSetAndOptions(cparams, EmptyAppOptions{}, genAccounts...)
+}
+
+// SetupTestAppWithGenesisValSetAndOptions is SetupTestAppWithGenesisValSet
+// with the provided app options.
+func SetupTestAppWithGenesisValSetAndOptions(cparams *tmproto.ConsensusParams, appOpts servertypes.AppOptions, genAccounts ...string) (*app.App, keyring.Keyring) {
+	// var cache sdk.MultiStorePersistentCache
 	// var anteOpt = func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(nil) }
 	db := dbm.NewMemDB()
 
@@ -60,10 +66,10 @@ func SetupTestAppWithGenesisValSet(cparams *tmproto.ConsensusParams, genAccounts
 
 	testApp := app.New(
 		log.NewNopLogger(), db, nil, true,
-		cast.ToUint(emptyOpts.Get(server.FlagInvCheckPeriod)),
+		cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod)),
 		encCfg,
 		nil,
-		emptyOpts,
+		appOpts,
 	)
 
 	genesisState, valSet, kr := GenesisStateWithSingleValidator(testApp, genAccounts...)
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/spf13/cobra"
+)
+
+// pfbEstimate is the output of PayForBlobs --estimate.
+type pfbEstimate struct {
+	Blobs []blobEstimate `json:"blobs"`
+	// Shares is the number of shares occupied by the blobs.
+	Shares int `json:"shares"`
+	// BlobGas is the gas consumed by the blobs.
+	BlobGas uint64 `json:"blob_gas"`
+	// Gas is the estimated gas limit of the transaction.
+	Gas uint64 `json:"gas"`
+	// Fee is the fee of the transaction at the provided gas price, or the
+	// default minimum gas price if none is provided.
+	Fee string `json:"fee"`
+}
+
+type blobEstimate struct {
+	Namespace string `json:"namespace"`
+	Size      int    `json:"size"`
+	Shares    int    `json:"shares"`
+}
+
+// estimatePFB prints the number of shares, gas and fee of a PayForBlobs
+// transaction for the provided blobs, using the gas params of the chain.
+func estimatePFB(cmd *cobra.Command, blobs []*blob.Blob) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	blobParams, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("querying blob params: %w", err)
+	}
+	authParams, err := authtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return fmt.Errorf("querying auth params: %w", err)
+	}
+
+	gasPrice := appconsts.DefaultMinGasPrice
+	if gasPrices, _ := cmd.Flags().GetString(flags.FlagGasPrices); gasPrices != "" {
+		decCoins, err := sdk.ParseDecCoins(gasPrices)
+		if err != nil {
+			return fmt.Errorf("parsing gas prices %q: %w", gasPrices, err)
+		}
+		gasPrice, err = decCoins.AmountOf(appconsts.BondDenom).Float64()
+		if err != nil {
+			return err
+		}
+	}
+
+	estimate := pfbEstimate{Blobs: make([]blobEstimate, len(blobs))}
+	blobSizes := make([]uint32, len(blobs))
+	for i, b := range blobs {
+		blobSizes[i] = uint32(len(b.Data))
+		estimate.Blobs[i] = blobEstimate{
+			Namespace: fmt.Sprintf("%x", append([]byte{byte(b.NamespaceVersion)}, b.NamespaceId...)),
+			Size:      len(b.Data),
+			Shares:    shares.SparseSharesNeeded(blobSizes[i]),
+		}
+		estimate.Shares += estimate.Blobs[i].Shares
+	}
+	estimate.BlobGas = types.GasToConsume(blobSizes, blobParams.Params.GasPerBlobByte)
+	estimate.Gas = types.EstimateGas(blobSizes, blobParams.Params.GasPerBlobByte, authParams.Params.TxSizeCostPerByte)
+	fee := sdk.NewInt64Coin(appconsts.BondDenom, int64(math.Ceil(float64(estimate.Gas)*gasPrice)))
+	estimate.Fee = fee.String()
+
+	out, err := json.Marshal(estimate)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(out)
+}
@@ -4,7 +4,9 @@ import (
 	"bufio"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -28,69 +30,163 @@ const (
 	// FlagNamespaceVersion allows the user to override the namespace version when
 	// submitting a PayForBlob.
 	FlagNamespaceVersion = "namespace-version"
+
+	// FlagBlob allows the user to provide blobs as namespaceID:path pairs
+	// when submitting a PayForBlob.
+	FlagBlob = "blob"
+
+	// FlagEstimate prints the estimated shares, gas and fee of a PayForBlob
+	// instead of broadcasting it.
+	FlagEstimate = "estimate"
 )
 
 func CmdPayForBlob() *cobra.Command {
 	cmd := &cobra.Command{
-		Use: "PayForBlobs namespaceID blob",
+		Use: "PayForBlobs [namespaceID blob]",
 		// This example command can be run in a new terminal after running single-node.sh
 		Example: "celestia-appd tx blob PayForBlobs 0x00010203040506070809 0x48656c6c6f2c20576f726c6421 \\\n" +
 			"\t--chain-id private \\\n" +
 			"\t--from validator \\\n" +
 			"\t--keyring-backend test \\\n" +
 			"\t--fees 21000utia \\\n" +
-			"\t--yes",
-		Short: "Pay for a data blob to be published to Celestia.",
-		Long: "Pay for a data blob to be published to Celestia.\n" +
-			"namespaceID is the user-specifiable portion of a version 0 namespace. It must be a hex encoded string of 10 bytes.\n" +
-			"blob must be a hex encoded string of any length.\n" +
-			// TODO: allow for more than one blob to be sumbmitted via the CLI
-			"This command currently only supports a single blob per invocation.\n",
+			"\t--yes\n\n" +
+			"celestia-appd tx blob PayForBlobs \\\n" +
+			"\t--blob 0x00010203040506070809:batch-1.bin \\\n" +
+			"\t--blob 0x0a0b0c0d0e0f10111213:- \\\n" +
+			"\t--from validator \\\n" +
+			"\t--yes < batch-2.bin\n\n" +
+			"celestia-appd tx blob PayForBlobs --blob 0x00010203040506070809:batch-1.bin --estimate",
+		Short: "Pay for data blobs to be published to Celestia.",
+		Long: "Pay for data blobs to be published to Celestia.\n" +
+			"A single blob can be provided as arguments: namespaceID is the user-specifiable portion of a version 0 namespace. " +
+			"It must be a hex encoded string of 10 bytes. blob must be a hex encoded string of any length.\n" +
+			"Alternatively, one or more blobs can be provided with the --blob flag as namespaceID:path pairs, " +
+			"where the blob is read from the file at path, or from stdin if path is -.\n" +
+			"With --estimate, the number of shares, gas and fee of the transaction are printed without broadcasting it.\n",
 		Aliases: []string{"PayForBlob"},
 		Args: func(cmd *cobra.Command, args []string) error {
-			if len(args) < 2 {
-				return fmt.Errorf("PayForBlobs requires two arguments: namespaceID and blob")
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("PayForBlobs requires either two arguments: namespaceID and blob, or the --%s flag", FlagBlob)
 			}
 			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			arg0 := strings.TrimPrefix(args[0], "0x")
-			namespaceID, err := hex.DecodeString(arg0)
-			if err != nil {
-				return fmt.Errorf("failed to decode hex namespace ID: %w", err)
-			}
-			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
-			if err != nil {
-				return err
-			}
-			namespace, err := getNamespace(namespaceID, namespaceVersion)
+			blobs, err := getBlobs(cmd, args)
 			if err != nil {
 				return err
 			}
 
-			arg1 := strings.TrimPrefix(args[1], "0x")
-			rawblob, err := hex.DecodeString(arg1)
-			if err != nil {
-				return fmt.Errorf("failure to decode hex blob: %w", err)
-			}
-
-			shareVersion, _ := cmd.Flags().GetUint8(FlagShareVersion)
-			blob, err := types.NewBlob(namespace, rawblob, shareVersion)
+			estimate, err := cmd.Flags().GetBool(FlagEstimate)
 			if err != nil {
 				return err
 			}
+			if estimate {
+				return estimatePFB(cmd, blobs)
+			}
 
-			return broadcastPFB(cmd, blob)
+			return broadcastPFB(cmd, blobs...)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
 	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
-	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	cmd.Flags().StringArray(FlagBlob, nil, "A blob as a namespaceID:path pair, where the blob is read from stdin if path is -. May be repeated")
+	cmd.Flags().Bool(FlagEstimate, false, "Print the number of shares, gas and fee of the transaction without broadcasting it")
 	return cmd
 }
 
+// getBlobs returns the blobs provided either as hex encoded arguments or with
+// the --blob flag.
+func getBlobs(cmd *cobra.Command, args []string) ([]*blob.Blob, error) {
+	namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+	if err != nil {
+		return nil, err
+	}
+	shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
+	if err != nil {
+		return nil, err
+	}
+	blobFlags, err := cmd.Flags().GetStringArray(FlagBlob)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(args) != 0 {
+		if len(blobFlags) != 0 {
+			return nil, fmt.Errorf("blobs can't be provided both as arguments and with the --%s flag", FlagBlob)
+		}
+		namespace, err := parseNamespace(args[0], namespaceVersion)
+		if err != nil {
+			return nil, err
+		}
+		rawblob, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failure to decode hex blob: %w", err)
+		}
+		b, err := types.NewBlob(namespace, rawblob, shareVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []*blob.Blob{b}, nil
+	}
+
+	if len(blobFlags) == 0 {
+		return nil, fmt.Errorf("at least one blob must be provided as arguments or with the --%s flag", FlagBlob)
+	}
+	readStdin := false
+	blobs := make([]*blob.Blob, len(blobFlags))
+	for i, blobFlag := range blobFlags {
+		hexNamespace, path, ok := strings.Cut(blobFlag, ":")
+		if !ok || path == "" {
+			return nil, fmt.Errorf("invalid --%s %q: expected namespaceID:path", FlagBlob, blobFlag)
+		}
+		namespace, err := parseNamespace(hexNamespace, namespaceVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		var rawblob []byte
+		if path == "-" {
+			if readStdin {
+				return nil, errors.New("only one blob can be read from stdin")
+			}
+			readStdin = true
+			rawblob, err = io.ReadAll(cmd.InOrStdin())
+		} else {
+			rawblob, err = os.ReadFile(path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading blob %q: %w", path, err)
+		}
+
+		blobs[i], err = types.NewBlob(namespace, rawblob, shareVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if readStdin {
+		// the confirmation prompt would otherwise be read from stdin
+		skipConfirm, _ := cmd.Flags().GetBool(flags.FlagSkipConfirmation)
+		estimate, _ := cmd.Flags().GetBool(FlagEstimate)
+		if !skipConfirm && !estimate {
+			return nil, fmt.Errorf("reading a blob from stdin requires --%s", flags.FlagSkipConfirmation)
+		}
+	}
+	return blobs, nil
+}
+
+// parseNamespace parses the hex encoded user-specifiable portion of a
+// namespace ID.
+func parseNamespace(hexNamespaceID string, namespaceVersion uint8) (appns.Namespace, error) {
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(hexNamespaceID, "0x"))
+	if err != nil {
+		return appns.Namespace{}, fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	return getNamespace(namespaceID, namespaceVersion)
+}
+
 func getNamespace(namespaceID []byte, namespaceVersion uint8) (appns.Namespace, error) {
 	switch namespaceVersion {
 	case appns.NamespaceVersionZero:
@@ -108,15 +204,16 @@ func getNamespace(namespaceID []byte, namespaceVersion uint8) (appns.Namespace,
 
 // broadcastPFB creates the new PFB message type that will later be broadcast to tendermint nodes
 // this private func is used in CmdPayForBlob
-func broadcastPFB(cmd *cobra.Command, b *blob.Blob) error {
+func broadcastPFB(cmd *cobra.Command, blobs ...*blob.Blob) error {
 	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
 		return err
 	}
+	if clientCtx.FromAddress.Empty() {
+		return fmt.Errorf("--%s is required to broadcast a PayForBlobs", flags.FlagFrom)
+	}
 
-	// TODO: allow the user to override the share version via a new flag
-	// See https://github.com/celestiaorg/celestia-app/issues/1041
-	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), b)
+	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), blobs...)
 	if err != nil {
 		return err
 	}
@@ -131,7 +228,7 @@ func broadcastPFB(cmd *cobra.Command, b *blob.Blob) error {
 		return err
 	}
 
-	blobTx, err := blob.MarshalBlobTx(txBytes, b)
+	blobTx, err := blob.MarshalBlobTx(txBytes, blobs...)
 	if err != nil {
 		return err
 	}
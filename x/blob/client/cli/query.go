@@ -19,7 +19,7 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryParams(), CmdQueryBlobs(), CmdQueryBlob())
 
 	return cmd
 }
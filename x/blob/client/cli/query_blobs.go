@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	// FlagNamespace filters the listed blobs by namespace.
+	FlagNamespace = "namespace"
+
+	// FlagOut is the path of the file the blob is written to.
+	FlagOut = "out"
+
+	// FlagProof is the path of the file the share inclusion proof of the blob
+	// is written to.
+	FlagProof = "proof"
+)
+
+// blobInfo describes a blob published in a block.
+type blobInfo struct {
+	Namespace       string `json:"namespace"`
+	Size            int    `json:"size"`
+	ShareCommitment string `json:"share_commitment"`
+	ShareStart      uint32 `json:"share_start"`
+	ShareEnd        uint32 `json:"share_end"`
+	Signer          string `json:"signer"`
+	TxIndex         uint32 `json:"tx_index"`
+	BlobIndex       uint32 `json:"blob_index"`
+}
+
+func newBlobInfo(pb types.PublishedBlob) blobInfo {
+	return blobInfo{
+		Namespace:       hex.EncodeToString(pb.Blob.Namespace().Bytes()),
+		Size:            len(pb.Blob.Data),
+		ShareCommitment: hex.EncodeToString(pb.ShareCommitment),
+		ShareStart:      pb.ShareStart,
+		ShareEnd:        pb.ShareEnd,
+		Signer:          pb.Signer,
+		TxIndex:         pb.TxIndex,
+		BlobIndex:       pb.BlobIndex,
+	}
+}
+
+func CmdQueryBlobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list [height]",
+		Short:   "lists the blobs published in the block at the given height",
+		Long:    "Lists the namespace, size, share commitment and share range of every blob published in the block at the given height, optionally filtered by the full hex encoded namespace.",
+		Example: "celestia-appd query blob list 100 --namespace 0000000000000000000000000000000000000000000102030405060708090a",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := parseHeight(args[0])
+			if err != nil {
+				return err
+			}
+
+			var namespace []byte
+			if hexNamespace, _ := cmd.Flags().GetString(FlagNamespace); hexNamespace != "" {
+				ns, err := parseFullNamespace(hexNamespace)
+				if err != nil {
+					return err
+				}
+				namespace = ns.Bytes()
+			}
+
+			queryClient := types.NewBlobQueryClient(clientCtx)
+			res, err := queryClient.BlobsByNamespace(cmd.Context(), &types.QueryBlobsByNamespaceRequest{
+				Height:    height,
+				Namespace: namespace,
+			})
+			if err != nil {
+				return err
+			}
+			blobs := make([]blobInfo, 0, len(res.Blobs))
+			for _, pb := range res.Blobs {
+				blobs = append(blobs, newBlobInfo(pb))
+			}
+
+			out, err := json.Marshal(blobs)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagNamespace, "", "Only list the blobs of the full hex encoded namespace")
+
+	return cmd
+}
+
+func CmdQueryBlob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [height] [namespace] [share-commitment]",
+		Short: "writes the blob with the given namespace and share commitment to a file",
+		Long: "Writes the raw data of the blob with the given full hex encoded namespace and hex encoded share commitment, " +
+			"published in the block at the given height, to a file. The share inclusion proof of the blob to the data root " +
+			"can optionally be written to another file.",
+		Example: "celestia-appd query blob get 100 0000000000000000000000000000000000000000000102030405060708090a <share-commitment> --out blob.bin --proof proof.json",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			height, err := parseHeight(args[0])
+			if err != nil {
+				return err
+			}
+			ns, err := parseFullNamespace(args[1])
+			if err != nil {
+				return err
+			}
+			commitment, err := hex.DecodeString(strings.TrimPrefix(args[2], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex share commitment: %w", err)
+			}
+
+			proofPath, _ := cmd.Flags().GetString(FlagProof)
+			queryClient := types.NewBlobQueryClient(clientCtx)
+			res, err := queryClient.BlobByCommitment(cmd.Context(), &types.QueryBlobByCommitmentRequest{
+				Height:          height,
+				Namespace:       ns.Bytes(),
+				ShareCommitment: commitment,
+				Prove:           proofPath != "",
+			})
+			if err != nil {
+				return err
+			}
+			pb := res.Blob
+
+			outPath, _ := cmd.Flags().GetString(FlagOut)
+			if outPath == "" {
+				outPath = fmt.Sprintf("%X.blob", commitment)
+			}
+			if err := os.WriteFile(outPath, pb.Blob.Data, 0o600); err != nil {
+				return err
+			}
+
+			if proofPath != "" {
+				var shareProof tmproto.ShareProof
+				if err := shareProof.Unmarshal(pb.ShareProof); err != nil {
+					return err
+				}
+				rawProof, err := clientCtx.Codec.MarshalJSON(&shareProof)
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(proofPath, rawProof, 0o600); err != nil {
+					return err
+				}
+			}
+
+			out, err := json.Marshal(newBlobInfo(pb))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOut, "", "Path of the file the blob is written to (default \"<share-commitment>.blob\")")
+	cmd.Flags().String(FlagProof, "", "Path of the file the JSON encoded share inclusion proof of the blob is written to, if any")
+
+	return cmd
+}
+
+// parseHeight parses the height of the block the blobs are read from.
+func parseHeight(arg string) (int64, error) {
+	height, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid height %q: %w", arg, err)
+	}
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be positive, got %d", height)
+	}
+	return height, nil
+}
+
+// parseFullNamespace parses a hex encoded namespace, including its version.
+func parseFullNamespace(hexNamespace string) (appns.Namespace, error) {
+	rawNamespace, err := hex.DecodeString(strings.TrimPrefix(hexNamespace, "0x"))
+	if err != nil {
+		return appns.Namespace{}, fmt.Errorf("failed to decode hex namespace: %w", err)
+	}
+	return appns.From(rawNamespace)
+}
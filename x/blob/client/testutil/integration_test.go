@@ -2,8 +2,12 @@ package testutil
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
@@ -19,10 +23,14 @@ import (
 	"github.com/celestiaorg/celestia-app/x/blob/types"
 
 	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
 	"github.com/celestiaorg/celestia-app/test/util/network"
 	"github.com/celestiaorg/celestia-app/test/util/testnode"
 	paycli "github.com/celestiaorg/celestia-app/x/blob/client/cli"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
 )
 
 // username is used to create a funded genesis account under this name
@@ -182,6 +190,152 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSubmitPayForBlobs() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	dir := s.T().TempDir()
+	blobFlags := make([]string, 2)
+	for i, size := range []int{1000, 5000} {
+		path := filepath.Join(dir, fmt.Sprintf("blob-%d.bin", i))
+		require.NoError(os.WriteFile(path, bytes.Repeat([]byte{byte(i + 1)}, size), 0o600))
+		blobFlags[i] = fmt.Sprintf("--%s=%x:%s", paycli.FlagBlob, appns.RandomBlobNamespaceID(), path)
+	}
+
+	s.Run("estimate", func() {
+		out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdPayForBlob(), append(blobFlags, fmt.Sprintf("--%s", paycli.FlagEstimate)))
+		require.NoError(err, out.String())
+
+		var estimate struct {
+			Shares int    `json:"shares"`
+			Gas    uint64 `json:"gas"`
+			Fee    string `json:"fee"`
+		}
+		require.NoError(json.Unmarshal(out.Bytes(), &estimate))
+		require.Equal(shares.SparseSharesNeeded(1000)+shares.SparseSharesNeeded(5000), estimate.Shares)
+		require.Equal(types.DefaultEstimateGas([]uint32{1000, 5000}), estimate.Gas)
+		require.NotEmpty(estimate.Fee)
+	})
+
+	s.Run("multiple blobs from files", func() {
+		s.Require().NoError(s.network.WaitForNextBlock())
+		args := append(blobFlags,
+			fmt.Sprintf("--from=%s", username),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%d", flags.FlagGas, types.DefaultEstimateGas([]uint32{1000, 5000})),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		)
+		out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdPayForBlob(), args)
+		require.NoError(err, out.String())
+
+		var txResp sdk.TxResponse
+		require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+		require.Equal(abci.CodeTypeOK, txResp.Code, txResp.RawLog)
+	})
+
+	s.Run("stdin requires skipping the confirmation", func() {
+		cmd := paycli.CmdPayForBlob()
+		cmd.SetIn(bytes.NewReader([]byte("blob")))
+		args := []string{
+			fmt.Sprintf("--%s=%x:-", paycli.FlagBlob, appns.RandomBlobNamespaceID()),
+			fmt.Sprintf("--from=%s", username),
+		}
+		_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+		require.Error(err)
+	})
+
+	s.Run("invalid blob flag", func() {
+		_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdPayForBlob(), []string{fmt.Sprintf("--%s=%x", paycli.FlagBlob, appns.RandomBlobNamespaceID()), fmt.Sprintf("--%s", paycli.FlagEstimate)})
+		require.Error(err)
+	})
+}
+
+func (s *IntegrationTestSuite) TestQueryBlobs() {
+	require := s.Require()
+	val := s.network.Validators[0]
+	namespaceID := appns.RandomBlobNamespaceID()
+	ns := appns.MustNewV0(namespaceID)
+	hexBlob := "0204033704032c0b162109000908094d425837422c2116"
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdPayForBlob(), []string{
+		hex.EncodeToString(namespaceID),
+		hexBlob,
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	})
+	require.NoError(err, out.String())
+	var txResp sdk.TxResponse
+	require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, txResp.RawLog)
+	height := strconv.FormatInt(txResp.Height, 10)
+
+	type blobInfo struct {
+		Namespace       string `json:"namespace"`
+		Size            int    `json:"size"`
+		ShareCommitment string `json:"share_commitment"`
+		ShareStart      uint32 `json:"share_start"`
+		ShareEnd        uint32 `json:"share_end"`
+	}
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdQueryBlobs(), []string{
+		height,
+		fmt.Sprintf("--%s=%x", paycli.FlagNamespace, ns.Bytes()),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	require.NoError(err, out.String())
+	var blobs []blobInfo
+	require.NoError(json.Unmarshal(out.Bytes(), &blobs), out.String())
+	require.Len(blobs, 1)
+	require.Equal(hex.EncodeToString(ns.Bytes()), blobs[0].Namespace)
+	require.Equal(len(hexBlob)/2, blobs[0].Size)
+	require.Equal(blobs[0].ShareStart+1, blobs[0].ShareEnd)
+
+	// without a namespace, the blobs of every namespace are listed
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdQueryBlobs(), []string{
+		height,
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	require.NoError(err, out.String())
+	var allBlobs []blobInfo
+	require.NoError(json.Unmarshal(out.Bytes(), &allBlobs), out.String())
+	require.Contains(allBlobs, blobs[0])
+
+	dir := s.T().TempDir()
+	blobPath, proofPath := filepath.Join(dir, "blob.bin"), filepath.Join(dir, "proof.json")
+	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdQueryBlob(), []string{
+		height,
+		blobs[0].Namespace,
+		blobs[0].ShareCommitment,
+		fmt.Sprintf("--%s=%s", paycli.FlagOut, blobPath),
+		fmt.Sprintf("--%s=%s", paycli.FlagProof, proofPath),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	require.NoError(err, out.String())
+	data, err := os.ReadFile(blobPath)
+	require.NoError(err)
+	require.Equal(hexBlob, hex.EncodeToString(data))
+
+	rawProof, err := os.ReadFile(proofPath)
+	require.NoError(err)
+	var shareProof tmproto.ShareProof
+	require.NoError(val.ClientCtx.Codec.UnmarshalJSON(rawProof, &shareProof))
+	proof, err := coretypes.ShareProofFromProto(shareProof)
+	require.NoError(err)
+	block, err := val.RPCClient.Block(context.Background(), &txResp.Height)
+	require.NoError(err)
+	require.NoError(proof.Validate(block.Block.DataHash))
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, paycli.CmdQueryBlob(), []string{
+		height,
+		blobs[0].Namespace,
+		hex.EncodeToString(bytes.Repeat([]byte{1}, 32)),
+		fmt.Sprintf("--%s=%s", paycli.FlagOut, blobPath),
+	})
+	require.Error(err)
+}
+
 // The "_Flaky" suffix indicates that the test may fail non-deterministically especially when executed in CI.
 func TestIntegrationTestSuite_Flaky(t *testing.T) {
 	suite.Run(t, NewIntegrationTestSuite(network.DefaultConfig()))
@@ -0,0 +1,341 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.BlobQueryServer = BlobQueryServer{}
+
+// BlobQueryServer implements the BlobQuery service. Blobs are not persisted in
+// state, so the blocks are fetched from the node using the client context.
+type BlobQueryServer struct {
+	clientCtx client.Context
+	txDecoder sdk.TxDecoder
+}
+
+// NewBlobQueryServer returns a BlobQueryServer that reads blocks using the
+// node of the provided client context and decodes the blob transactions
+// using txDecoder.
+func NewBlobQueryServer(clientCtx client.Context, txDecoder sdk.TxDecoder) BlobQueryServer {
+	return BlobQueryServer{
+		clientCtx: clientCtx,
+		txDecoder: txDecoder,
+	}
+}
+
+func (s BlobQueryServer) BlobsByNamespace(
+	c context.Context,
+	req *types.QueryBlobsByNamespaceRequest,
+) (*types.QueryBlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	// an empty namespace returns the blobs of every namespace
+	var ns *appns.Namespace
+	if len(req.Namespace) != 0 {
+		parsed, err := appns.From(req.Namespace)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		ns = &parsed
+	}
+
+	block, err := s.getBlock(c, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, published, err := PublishedBlobs(block.Data.Txs.ToSliceOfBytes(), block.Header.Version.App, s.txDecoder)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	blobs := make([]types.PublishedBlob, 0)
+	for _, pb := range published {
+		if ns == nil || pb.Blob.Namespace().Equals(*ns) {
+			blobs = append(blobs, pb)
+		}
+	}
+	if req.Prove {
+		toProve := make([]*types.PublishedBlob, len(blobs))
+		for i := range blobs {
+			toProve[i] = &blobs[i]
+		}
+		if err := ProvePublishedBlobs(dataSquare, toProve); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryBlobsByNamespaceResponse{Blobs: blobs, Height: block.Height}, nil
+}
+
+func (s BlobQueryServer) BlobByCommitment(
+	c context.Context,
+	req *types.QueryBlobByCommitmentRequest,
+) (*types.QueryBlobByCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ns, err := appns.From(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.ShareCommitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "share commitment cannot be empty")
+	}
+
+	block, err := s.getBlock(c, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, published, err := PublishedBlobs(block.Data.Txs.ToSliceOfBytes(), block.Header.Version.App, s.txDecoder)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pb, found := findPublishedBlob(published, ns, req.ShareCommitment)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no blob with share commitment %X in namespace %X at height %d", req.ShareCommitment, ns.Bytes(), block.Height)
+	}
+	if req.Prove {
+		if err := ProvePublishedBlob(dataSquare, &pb); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return &types.QueryBlobByCommitmentResponse{Blob: pb, Height: block.Height}, nil
+}
+
+func (s BlobQueryServer) CommitmentProof(
+	c context.Context,
+	req *types.QueryCommitmentProofRequest,
+) (*types.QueryCommitmentProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ns, err := appns.From(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.ShareCommitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "share commitment cannot be empty")
+	}
+
+	block, err := s.getBlock(c, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, published, err := PublishedBlobs(block.Data.Txs.ToSliceOfBytes(), block.Header.Version.App, s.txDecoder)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pb, found := findPublishedBlob(published, ns, req.ShareCommitment)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no blob with share commitment %X in namespace %X at height %d", req.ShareCommitment, ns.Bytes(), block.Height)
+	}
+	rawProof, err := ProveCommitment(dataSquare, pb, block.Header.Version.App)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryCommitmentProofResponse{Proof: rawProof, Height: block.Height}, nil
+}
+
+// findPublishedBlob returns the first published blob of the namespace with
+// the provided share commitment.
+func findPublishedBlob(published []types.PublishedBlob, ns appns.Namespace, commitment []byte) (types.PublishedBlob, bool) {
+	for _, pb := range published {
+		if pb.Blob.Namespace().Equals(ns) && bytes.Equal(pb.ShareCommitment, commitment) {
+			return pb, true
+		}
+	}
+	return types.PublishedBlob{}, false
+}
+
+// getBlock fetches the block at the given height from the node. A height of
+// zero returns the latest block.
+func (s BlobQueryServer) getBlock(c context.Context, height int64) (*coretypes.Block, error) {
+	if height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d cannot be negative", height)
+	}
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	var h *int64
+	if height != 0 {
+		h = &height
+	}
+	res, err := node.Block(c, h)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return res.Block, nil
+}
+
+// PublishedBlobs constructs the data square from the block transactions and
+// returns it along with every blob that was published in it, ordered by
+// transaction index and then by blob index. The share proofs of the returned
+// blobs are not set; see ProvePublishedBlob.
+func PublishedBlobs(txs [][]byte, appVersion uint64, decoder sdk.TxDecoder) (square.Square, []types.PublishedBlob, error) {
+	// As we don't have access to the state at the height of the block we use
+	// the upper bound square size instead of the one dictated by governance.
+	builder, err := square.NewBuilder(appconsts.SquareSizeUpperBound(appVersion), appVersion, txs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	published := make([]types.PublishedBlob, 0)
+	for txIndex, tx := range txs {
+		blobTx, isBlobTx := blob.UnmarshalBlobTx(tx)
+		if !isBlobTx {
+			continue
+		}
+		pfb, err := decodePFB(decoder, blobTx.Tx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("tx %d: %w", txIndex, err)
+		}
+		if len(pfb.ShareCommitments) != len(blobTx.Blobs) {
+			return nil, nil, fmt.Errorf("tx %d: %w", txIndex, types.ErrMismatchedNumberOfPFBorBlob)
+		}
+
+		for blobIndex, b := range blobTx.Blobs {
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			published = append(published, types.PublishedBlob{
+				Blob:            b,
+				ShareCommitment: pfb.ShareCommitments[blobIndex],
+				Signer:          pfb.Signer,
+				TxIndex:         uint32(txIndex),
+				BlobIndex:       uint32(blobIndex),
+				ShareStart:      uint32(start),
+				ShareEnd:        uint32(start + length),
+			})
+		}
+	}
+
+	return dataSquare, published, nil
+}
+
+// ProvePublishedBlob sets the share proof of the published blob to an NMT
+// inclusion proof of the blob's shares to the data root of dataSquare. The
+// share range of the blob must be in dataSquare and its shares must belong to
+// the namespace of the blob.
+func ProvePublishedBlob(dataSquare square.Square, pb *types.PublishedBlob) error {
+	return ProvePublishedBlobs(dataSquare, []*types.PublishedBlob{pb})
+}
+
+// ProvePublishedBlobs is like ProvePublishedBlob for several blobs published
+// in dataSquare, which is only extended once for all of them.
+func ProvePublishedBlobs(dataSquare square.Square, published []*types.PublishedBlob) error {
+	if len(published) == 0 {
+		return nil
+	}
+	for _, pb := range published {
+		if err := validateShareRange(dataSquare, pb); err != nil {
+			return err
+		}
+	}
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	if err != nil {
+		return err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return err
+	}
+	for _, pb := range published {
+		shareRange := shares.NewRange(int(pb.ShareStart), int(pb.ShareEnd))
+		shareProof, err := proof.NewShareInclusionProofFromEDS(eds, dah, pb.Blob.Namespace(), shareRange)
+		if err != nil {
+			return err
+		}
+		pShareProof := shareProof.ToProto()
+		rawShareProof, err := pShareProof.Marshal()
+		if err != nil {
+			return err
+		}
+		pb.ShareProof = rawShareProof
+	}
+	return nil
+}
+
+// validateShareRange checks that the share range of the published blob is in
+// dataSquare and that its shares belong to the namespace of the blob.
+func validateShareRange(dataSquare square.Square, pb *types.PublishedBlob) error {
+	shareRange := shares.NewRange(int(pb.ShareStart), int(pb.ShareEnd))
+	if shareRange.Start >= shareRange.End || shareRange.End > len(dataSquare) {
+		return fmt.Errorf("share range [%d, %d) is not in the data square of %d shares", shareRange.Start, shareRange.End, len(dataSquare))
+	}
+	ns := pb.Blob.Namespace()
+	for i := shareRange.Start; i < shareRange.End; i++ {
+		shareNamespace, err := dataSquare[i].Namespace()
+		if err != nil {
+			return err
+		}
+		if !shareNamespace.Equals(ns) {
+			return fmt.Errorf("share %d of namespace %X doesn't belong to the namespace %X of the blob", i, shareNamespace.Bytes(), ns.Bytes())
+		}
+	}
+	return nil
+}
+
+// ProveCommitment returns the protobuf encoded proof.CommitmentProof of the
+// share commitment of the published blob to the data root of dataSquare.
+func ProveCommitment(dataSquare square.Square, pb types.PublishedBlob, appVersion uint64) ([]byte, error) {
+	commitmentProof, err := proof.NewCommitmentProof(
+		dataSquare,
+		shares.NewRange(int(pb.ShareStart), int(pb.ShareEnd)),
+		appconsts.SubtreeRootThreshold(appVersion),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// defensively check that the proof is for the share commitment of the
+	// PFB that paid for the blob.
+	if !bytes.Equal(commitmentProof.ShareCommitment, pb.ShareCommitment) {
+		return nil, fmt.Errorf("share commitment of the proof %X doesn't match the one of the blob %X", commitmentProof.ShareCommitment, pb.ShareCommitment)
+	}
+	return commitmentProof.Marshal()
+}
+
+func decodePFB(decoder sdk.TxDecoder, rawTx []byte) (*types.MsgPayForBlobs, error) {
+	sdkTx, err := decoder(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, types.ErrMultipleMsgsInBlobTx
+	}
+	pfb, ok := msgs[0].(*types.MsgPayForBlobs)
+	if !ok {
+		return nil, types.ErrNoPFB
+	}
+	return pfb, nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/blob"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/inclusion"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestPublishedBlobs(t *testing.T) {
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	ns1 := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	ns2 := appns.MustNewV0(bytes.Repeat([]byte{2}, appns.NamespaceVersionZeroIDSize))
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns2, ns1, ns2}, []int{500, 2000, 10000})
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	txs = append(txs, blobTxs...)
+
+	dataSquare, published, err := keeper.PublishedBlobs(txs.ToSliceOfBytes(), appconsts.LatestVersion, ecfg.TxConfig.TxDecoder())
+	require.NoError(t, err)
+	require.Len(t, published, 3)
+
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	// the share proofs of all the blobs are created from a single extended
+	// data square
+	toProve := make([]*types.PublishedBlob, len(published))
+	for i := range published {
+		require.Nil(t, published[i].ShareProof)
+		toProve[i] = &published[i]
+	}
+	require.NoError(t, keeper.ProvePublishedBlobs(dataSquare, toProve))
+
+	for i, pb := range published {
+		require.EqualValues(t, 10+i, pb.TxIndex)
+		require.EqualValues(t, 0, pb.BlobIndex)
+		require.Equal(t, signer.Address().String(), pb.Signer)
+
+		parsed, err := shares.ParseBlobs(dataSquare[pb.ShareStart:pb.ShareEnd])
+		require.NoError(t, err)
+		require.Len(t, parsed, 1)
+		require.Equal(t, pb.Blob, parsed[0])
+
+		commitment, err := inclusion.CreateCommitment(pb.Blob)
+		require.NoError(t, err)
+		require.Equal(t, commitment, pb.ShareCommitment)
+
+		var pShareProof tmproto.ShareProof
+		require.NoError(t, pShareProof.Unmarshal(pb.ShareProof))
+		shareProof, err := coretypes.ShareProofFromProto(pShareProof)
+		require.NoError(t, err)
+		require.NoError(t, shareProof.Validate(dah.Hash()))
+
+		rawCommitmentProof, err := keeper.ProveCommitment(dataSquare, pb, appconsts.LatestVersion)
+		require.NoError(t, err)
+		var commitmentProof proof.CommitmentProof
+		require.NoError(t, commitmentProof.Unmarshal(rawCommitmentProof))
+		require.NoError(t, commitmentProof.Validate(dah.Hash(), pb.ShareCommitment, appconsts.LatestVersion))
+	}
+}
+
+func TestPublishedBlobsNoBlobs(t *testing.T) {
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txs := testfactory.GenerateRandomTxs(10, 500)
+
+	_, published, err := keeper.PublishedBlobs(txs.ToSliceOfBytes(), appconsts.LatestVersion, ecfg.TxConfig.TxDecoder())
+	require.NoError(t, err)
+	require.Empty(t, published)
+}
+
+func TestPublishedBlobsInvalidBlobTx(t *testing.T) {
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	send := banktypes.NewMsgSend(signer.Address(), signer.Address(), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))
+	pfb, b := blobfactory.RandMsgPayForBlobsWithNamespaceAndSigner(signer.Address().String(), ns, 100)
+
+	sendTx, err := signer.CreateTx([]sdk.Msg{send}, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	pfbTx, err := signer.CreateTx([]sdk.Msg{pfb}, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		tx      []byte
+		blobs   []*blob.Blob
+		wantErr error
+	}{
+		{
+			name:  "undecodable tx",
+			tx:    []byte("not a tx"),
+			blobs: []*blob.Blob{b},
+		},
+		{
+			name:    "no PFB",
+			tx:      sendTx,
+			blobs:   []*blob.Blob{b},
+			wantErr: types.ErrNoPFB,
+		},
+		{
+			name:    "PFB with other messages",
+			tx:      blobfactory.ComplexBlobTxWithOtherMsgs(t, tmrand.NewRand(), signer, send),
+			wantErr: types.ErrMultipleMsgsInBlobTx,
+		},
+		{
+			name:    "more blobs than share commitments",
+			tx:      pfbTx,
+			blobs:   []*blob.Blob{b, b},
+			wantErr: types.ErrMismatchedNumberOfPFBorBlob,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blobTx := tc.tx
+			if len(tc.blobs) > 0 {
+				blobTx, err = blob.MarshalBlobTx(tc.tx, tc.blobs...)
+				require.NoError(t, err)
+			}
+			_, _, err := keeper.PublishedBlobs([][]byte{blobTx}, appconsts.LatestVersion, ecfg.TxConfig.TxDecoder())
+			require.Error(t, err)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestProvePublishedBlobInvalidShareRange(t *testing.T) {
+	ecfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	ns := appns.MustNewV0(bytes.Repeat([]byte{1}, appns.NamespaceVersionZeroIDSize))
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := append(testfactory.GenerateRandomTxs(10, 500), blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []appns.Namespace{ns}, []int{2000})...)
+
+	dataSquare, published, err := keeper.PublishedBlobs(txs.ToSliceOfBytes(), appconsts.LatestVersion, ecfg.TxConfig.TxDecoder())
+	require.NoError(t, err)
+	require.Len(t, published, 1)
+	pb := published[0]
+
+	testCases := []struct {
+		name       string
+		start, end uint32
+	}{
+		{name: "empty range", start: pb.ShareStart, end: pb.ShareStart},
+		{name: "end outside of the square", start: pb.ShareStart, end: uint32(len(dataSquare) + 1)},
+		{name: "shares of another namespace", start: 0, end: pb.ShareEnd},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			invalid := pb
+			invalid.ShareStart, invalid.ShareEnd = tc.start, tc.end
+			require.Error(t, keeper.ProvePublishedBlob(dataSquare, &invalid))
+			require.Nil(t, invalid.ShareProof)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	blob "github.com/celestiaorg/celestia-app/pkg/blob"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -111,33 +112,555 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBlobsByNamespaceRequest is the request type for the
+// BlobQuery/BlobsByNamespace RPC method.
+type QueryBlobsByNamespaceRequest struct {
+	// height of the block. The latest block is used if height is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace (version and ID) of the blobs. The blobs
+	// of every namespace are returned if it is empty.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// prove indicates whether a share inclusion proof should be returned for
+	// each blob.
+	Prove bool `protobuf:"varint,3,opt,name=prove,proto3" json:"prove,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceRequest) Reset()         { *m = QueryBlobsByNamespaceRequest{} }
+func (m *QueryBlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceRequest) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{2}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
+// QueryBlobsByNamespaceResponse is the response type for the
+// BlobQuery/BlobsByNamespace RPC method.
+type QueryBlobsByNamespaceResponse struct {
+	Blobs []PublishedBlob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs"`
+	// height of the block the blobs were read from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlobsByNamespaceResponse) Reset()         { *m = QueryBlobsByNamespaceResponse{} }
+func (m *QueryBlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobsByNamespaceResponse) ProtoMessage()    {}
+func (*QueryBlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{3}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *QueryBlobsByNamespaceResponse) GetBlobs() []PublishedBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBlobByCommitmentRequest is the request type for the
+// BlobQuery/BlobByCommitment RPC method.
+type QueryBlobByCommitmentRequest struct {
+	// height of the block. The latest block is used if height is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace (version and ID) of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// share_commitment is the share commitment of the blob as included in the
+	// MsgPayForBlobs that paid for it.
+	ShareCommitment []byte `protobuf:"bytes,3,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// prove indicates whether a share inclusion proof should be returned.
+	Prove bool `protobuf:"varint,4,opt,name=prove,proto3" json:"prove,omitempty"`
+}
+
+func (m *QueryBlobByCommitmentRequest) Reset()         { *m = QueryBlobByCommitmentRequest{} }
+func (m *QueryBlobByCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobByCommitmentRequest) ProtoMessage()    {}
+func (*QueryBlobByCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{4}
+}
+func (m *QueryBlobByCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobByCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobByCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobByCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobByCommitmentRequest.Merge(m, src)
+}
+func (m *QueryBlobByCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobByCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobByCommitmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobByCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryBlobByCommitmentRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryBlobByCommitmentRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryBlobByCommitmentRequest) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *QueryBlobByCommitmentRequest) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
+// QueryBlobByCommitmentResponse is the response type for the
+// BlobQuery/BlobByCommitment RPC method.
+type QueryBlobByCommitmentResponse struct {
+	Blob PublishedBlob `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob"`
+	// height of the block the blob was read from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlobByCommitmentResponse) Reset()         { *m = QueryBlobByCommitmentResponse{} }
+func (m *QueryBlobByCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobByCommitmentResponse) ProtoMessage()    {}
+func (*QueryBlobByCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{5}
+}
+func (m *QueryBlobByCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobByCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobByCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobByCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobByCommitmentResponse.Merge(m, src)
+}
+func (m *QueryBlobByCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobByCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobByCommitmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobByCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryBlobByCommitmentResponse) GetBlob() PublishedBlob {
+	if m != nil {
+		return m.Blob
+	}
+	return PublishedBlob{}
+}
+
+func (m *QueryBlobByCommitmentResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryCommitmentProofRequest is the request type for the
+// BlobQuery/CommitmentProof RPC method.
+type QueryCommitmentProofRequest struct {
+	// height of the block. The latest block is used if height is zero.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// namespace is the full namespace (version and ID) of the blob.
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// share_commitment is the share commitment of the blob as included in the
+	// MsgPayForBlobs that paid for it.
+	ShareCommitment []byte `protobuf:"bytes,3,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+}
+
+func (m *QueryCommitmentProofRequest) Reset()         { *m = QueryCommitmentProofRequest{} }
+func (m *QueryCommitmentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentProofRequest) ProtoMessage()    {}
+func (*QueryCommitmentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{6}
+}
+func (m *QueryCommitmentProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitmentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentProofRequest.Merge(m, src)
+}
+func (m *QueryCommitmentProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitmentProofRequest proto.InternalMessageInfo
+
+func (m *QueryCommitmentProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryCommitmentProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *QueryCommitmentProofRequest) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+// QueryCommitmentProofResponse is the response type for the
+// BlobQuery/CommitmentProof RPC method.
+type QueryCommitmentProofResponse struct {
+	// proof is the protobuf encoded celestia.core.v1.proof.CommitmentProof of
+	// the blob's share commitment to the data root.
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// height of the block the proof was created from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryCommitmentProofResponse) Reset()         { *m = QueryCommitmentProofResponse{} }
+func (m *QueryCommitmentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentProofResponse) ProtoMessage()    {}
+func (*QueryCommitmentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{7}
+}
+func (m *QueryCommitmentProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommitmentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentProofResponse.Merge(m, src)
+}
+func (m *QueryCommitmentProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommitmentProofResponse proto.InternalMessageInfo
+
+func (m *QueryCommitmentProofResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryCommitmentProofResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// PublishedBlob is a blob that was included in a block along with the
+// location of its shares in the data square.
+type PublishedBlob struct {
+	Blob *blob.Blob `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	// share_commitment is the share commitment of the blob.
+	ShareCommitment []byte `protobuf:"bytes,2,opt,name=share_commitment,json=shareCommitment,proto3" json:"share_commitment,omitempty"`
+	// signer is the bech32 address of the account that paid for the blob.
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// tx_index is the index of the blob transaction in the block.
+	TxIndex uint32 `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// blob_index is the index of the blob in its blob transaction.
+	BlobIndex uint32 `protobuf:"varint,5,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	// share_start is the index of the first share of the blob in the data
+	// square.
+	ShareStart uint32 `protobuf:"varint,6,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	// share_end is the index of the share following the last share of the blob
+	// in the data square (i.e. it is exclusive).
+	ShareEnd uint32 `protobuf:"varint,7,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+	// share_proof is the protobuf encoded tendermint.types.ShareProof of the
+	// blob's shares to the data root. It is only set if it was requested.
+	ShareProof []byte `protobuf:"bytes,8,opt,name=share_proof,json=shareProof,proto3" json:"share_proof,omitempty"`
+}
+
+func (m *PublishedBlob) Reset()         { *m = PublishedBlob{} }
+func (m *PublishedBlob) String() string { return proto.CompactTextString(m) }
+func (*PublishedBlob) ProtoMessage()    {}
+func (*PublishedBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ba8a4248383b64, []int{8}
+}
+func (m *PublishedBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishedBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishedBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PublishedBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishedBlob.Merge(m, src)
+}
+func (m *PublishedBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishedBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishedBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishedBlob proto.InternalMessageInfo
+
+func (m *PublishedBlob) GetBlob() *blob.Blob {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *PublishedBlob) GetShareCommitment() []byte {
+	if m != nil {
+		return m.ShareCommitment
+	}
+	return nil
+}
+
+func (m *PublishedBlob) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *PublishedBlob) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *PublishedBlob) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *PublishedBlob) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
+}
+
+func (m *PublishedBlob) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
+}
+
+func (m *PublishedBlob) GetShareProof() []byte {
+	if m != nil {
+		return m.ShareProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.blob.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.blob.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlobsByNamespaceRequest)(nil), "celestia.blob.v1.QueryBlobsByNamespaceRequest")
+	proto.RegisterType((*QueryBlobsByNamespaceResponse)(nil), "celestia.blob.v1.QueryBlobsByNamespaceResponse")
+	proto.RegisterType((*QueryBlobByCommitmentRequest)(nil), "celestia.blob.v1.QueryBlobByCommitmentRequest")
+	proto.RegisterType((*QueryBlobByCommitmentResponse)(nil), "celestia.blob.v1.QueryBlobByCommitmentResponse")
+	proto.RegisterType((*QueryCommitmentProofRequest)(nil), "celestia.blob.v1.QueryCommitmentProofRequest")
+	proto.RegisterType((*QueryCommitmentProofResponse)(nil), "celestia.blob.v1.QueryCommitmentProofResponse")
+	proto.RegisterType((*PublishedBlob)(nil), "celestia.blob.v1.PublishedBlob")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/query.proto", fileDescriptor_29ba8a4248383b64) }
 
 var fileDescriptor_29ba8a4248383b64 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x16, 0xc3, 0x8e, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xb4, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xce, 0x00, 0xb0, 0x60, 0x50, 0x6a, 0x61,
-	0x69, 0x6a, 0x71, 0x89, 0x92, 0x2f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x19, 0x17, 0x1b, 0x44, 0xb3, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e, 0xba,
-	0x13, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x36, 0x2a, 0xe7,
-	0x62, 0x05, 0x1b, 0x27, 0x94, 0xc7, 0xc5, 0x06, 0x51, 0x20, 0xa4, 0x82, 0xa9, 0x15, 0xd3, 0x1d,
-	0x52, 0xaa, 0x04, 0x54, 0x41, 0xdc, 0xa5, 0x24, 0xde, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x41, 0x21,
-	0x7e, 0x34, 0x3f, 0x3a, 0x79, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
-	0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xcc, 0x8e, 0xfc, 0xa2,
-	0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0xbf, 0x02, 0x62, 0x5e, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0xc0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x7e, 0x20, 0x5f, 0xb5,
-	0x01, 0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6a, 0x13, 0x41,
+	0x18, 0xcf, 0xa6, 0x49, 0x9a, 0x7c, 0x6d, 0x69, 0x1d, 0x4b, 0x8d, 0x69, 0x9a, 0x86, 0xd5, 0x42,
+	0x44, 0xba, 0x6b, 0x23, 0x14, 0x45, 0x10, 0x4c, 0xf1, 0x50, 0x51, 0xa9, 0xeb, 0xcd, 0x4b, 0xd9,
+	0x24, 0xd3, 0xcd, 0x4a, 0xb2, 0xb3, 0xdd, 0x9d, 0xd4, 0x84, 0x52, 0x0f, 0x3e, 0x81, 0x20, 0xde,
+	0x3c, 0x88, 0xaf, 0xe0, 0xd1, 0x17, 0xe8, 0xb1, 0xe0, 0xc5, 0x93, 0x48, 0xeb, 0x83, 0xc8, 0x7c,
+	0x33, 0xf9, 0x9f, 0xd4, 0x80, 0xe0, 0x6d, 0xe7, 0xfb, 0x33, 0xbf, 0x3f, 0x33, 0xdf, 0x2c, 0x64,
+	0x2b, 0xb4, 0x4e, 0x43, 0xee, 0xda, 0x66, 0xb9, 0xce, 0xca, 0xe6, 0xd1, 0x96, 0x79, 0xd8, 0xa4,
+	0x41, 0xdb, 0xf0, 0x03, 0xc6, 0x19, 0x59, 0xea, 0x64, 0x0d, 0x91, 0x35, 0x8e, 0xb6, 0x32, 0xcb,
+	0x0e, 0x73, 0x18, 0x26, 0x4d, 0xf1, 0x25, 0xeb, 0x32, 0x59, 0x87, 0x31, 0xa7, 0x4e, 0x4d, 0xdb,
+	0x77, 0x4d, 0xdb, 0xf3, 0x18, 0xb7, 0xb9, 0xcb, 0xbc, 0x50, 0x65, 0xd7, 0x46, 0x30, 0x7c, 0x3b,
+	0xb0, 0x1b, 0x9d, 0x74, 0xbe, 0x9b, 0xae, 0xb0, 0x80, 0x8a, 0x34, 0x96, 0x21, 0x22, 0x56, 0xe8,
+	0xcb, 0x40, 0x5e, 0x08, 0x56, 0x7b, 0xd8, 0x66, 0xd1, 0xc3, 0x26, 0x0d, 0xb9, 0xfe, 0x0c, 0xae,
+	0x0e, 0x44, 0x43, 0x9f, 0x79, 0x21, 0x25, 0xdb, 0x90, 0x90, 0xdb, 0xa7, 0xb5, 0xbc, 0x56, 0x98,
+	0x2b, 0xa6, 0x8d, 0x61, 0x11, 0x86, 0xec, 0x28, 0xc5, 0x4e, 0x7f, 0xae, 0x47, 0x2c, 0x55, 0xad,
+	0xbf, 0x86, 0x2c, 0x6e, 0x57, 0xaa, 0xb3, 0x72, 0x58, 0x6a, 0x3f, 0xb7, 0x1b, 0x34, 0xf4, 0xed,
+	0x0a, 0x55, 0x70, 0x64, 0x05, 0x12, 0x35, 0xea, 0x3a, 0x35, 0x8e, 0xfb, 0xce, 0x58, 0x6a, 0x45,
+	0xb2, 0x90, 0xf2, 0x3a, 0xb5, 0xe9, 0x68, 0x5e, 0x2b, 0xcc, 0x5b, 0xbd, 0x00, 0x59, 0x86, 0xb8,
+	0x1f, 0xb0, 0x23, 0x9a, 0x9e, 0xc9, 0x6b, 0x85, 0xa4, 0x25, 0x17, 0x3a, 0x87, 0xb5, 0x09, 0x58,
+	0x4a, 0xc4, 0x03, 0x88, 0x0b, 0xb2, 0x42, 0xc3, 0x4c, 0x61, 0xae, 0xb8, 0x3e, 0x46, 0x43, 0xb3,
+	0x5c, 0x77, 0xc3, 0x1a, 0xad, 0x8a, 0x3d, 0x94, 0x14, 0xd9, 0xd3, 0xc7, 0x34, 0xda, 0xcf, 0x54,
+	0xff, 0xa8, 0xf5, 0x49, 0x2c, 0xb5, 0x77, 0x58, 0xa3, 0xe1, 0xf2, 0x06, 0xf5, 0xf8, 0xbf, 0x49,
+	0xbc, 0x05, 0x4b, 0x61, 0xcd, 0x0e, 0xe8, 0x7e, 0xa5, 0xbb, 0x21, 0xaa, 0x9d, 0xb7, 0x16, 0x31,
+	0xde, 0xc3, 0xe9, 0xb9, 0x11, 0xeb, 0x77, 0x23, 0xe8, 0x73, 0x63, 0x90, 0x96, 0x72, 0xe3, 0x3e,
+	0xc4, 0x84, 0x32, 0x75, 0xa0, 0x53, 0x9a, 0x81, 0x2d, 0x13, 0xbd, 0x78, 0x0b, 0xab, 0x88, 0xd9,
+	0x43, 0xdb, 0x0b, 0x18, 0x3b, 0xf8, 0x5f, 0x4e, 0xe8, 0x4f, 0xd5, 0x51, 0x8c, 0xe0, 0x2b, 0xc9,
+	0xd2, 0x29, 0x76, 0x80, 0xf8, 0xf3, 0x96, 0x5c, 0x4c, 0x54, 0xf3, 0x29, 0x0a, 0x0b, 0x03, 0x1e,
+	0x10, 0x73, 0xc0, 0xb2, 0xd5, 0x9e, 0x65, 0x62, 0xc6, 0x84, 0x65, 0x68, 0x9d, 0x28, 0x55, 0x46,
+	0x8d, 0xe3, 0x1e, 0x1d, 0x7f, 0x8a, 0x2b, 0x90, 0x08, 0x5d, 0xc7, 0xa3, 0x01, 0x8a, 0x4b, 0x59,
+	0x6a, 0x45, 0xae, 0x43, 0x92, 0xb7, 0xf6, 0x5d, 0xaf, 0x4a, 0x5b, 0x78, 0xc0, 0x0b, 0xd6, 0x2c,
+	0x6f, 0xed, 0x8a, 0x25, 0x59, 0x03, 0x10, 0x28, 0x2a, 0x19, 0xc7, 0x64, 0x4a, 0x44, 0x64, 0x7a,
+	0x1d, 0xe6, 0x24, 0x78, 0xc8, 0xed, 0x80, 0xa7, 0x13, 0x98, 0x07, 0x0c, 0xbd, 0x14, 0x11, 0xb2,
+	0x0a, 0x29, 0x59, 0x40, 0xbd, 0x6a, 0x7a, 0x16, 0xd3, 0x49, 0x0c, 0x3c, 0xf6, 0xaa, 0xbd, 0x6e,
+	0xe9, 0x58, 0x12, 0x59, 0xcb, 0x6e, 0x34, 0xb5, 0xf8, 0x06, 0xe2, 0x68, 0x36, 0xf1, 0x20, 0x21,
+	0x67, 0x9f, 0xdc, 0x1c, 0xbd, 0x44, 0xa3, 0x4f, 0x4c, 0x66, 0xe3, 0x2f, 0x55, 0xf2, 0xb0, 0xf4,
+	0x6b, 0xef, 0xbe, 0xff, 0xfe, 0x10, 0xbd, 0x42, 0x16, 0x87, 0x1e, 0xb8, 0xe2, 0x97, 0x18, 0xa4,
+	0x84, 0xc7, 0x12, 0xfd, 0xb3, 0x06, 0x4b, 0xc3, 0x13, 0x4f, 0x8c, 0x09, 0x10, 0x13, 0x9e, 0xa1,
+	0x8c, 0x39, 0x75, 0xbd, 0x22, 0x77, 0x1b, 0xc9, 0x6d, 0x90, 0x1b, 0x5d, 0x72, 0xf8, 0x4a, 0x98,
+	0xc7, 0xf2, 0xee, 0x9c, 0x98, 0xc7, 0xdd, 0x0b, 0x7c, 0x42, 0xbe, 0x2a, 0x8a, 0xfd, 0x63, 0x78,
+	0x29, 0xc5, 0x31, 0xcf, 0xc8, 0xa5, 0x14, 0xc7, 0xcd, 0xb7, 0xfe, 0x10, 0x29, 0xde, 0x23, 0xdb,
+	0x53, 0x50, 0x34, 0x8f, 0x87, 0xaf, 0xe9, 0x09, 0xf9, 0xa6, 0xc1, 0xe2, 0xd0, 0x20, 0x91, 0xcd,
+	0x09, 0x24, 0xc6, 0x0f, 0x7c, 0xc6, 0x98, 0xb6, 0x5c, 0x51, 0xde, 0x45, 0xca, 0x3b, 0xe4, 0x51,
+	0x97, 0x72, 0x8f, 0x90, 0xbc, 0x87, 0xd3, 0xb2, 0x2f, 0x3d, 0x39, 0x3d, 0xcf, 0x69, 0x67, 0xe7,
+	0x39, 0xed, 0xd7, 0x79, 0x4e, 0x7b, 0x7f, 0x91, 0x8b, 0x9c, 0x5d, 0xe4, 0x22, 0x3f, 0x2e, 0x72,
+	0x91, 0x57, 0x77, 0x1c, 0x97, 0xd7, 0x9a, 0x65, 0xa3, 0xc2, 0x1a, 0x66, 0x87, 0x1e, 0x0b, 0x9c,
+	0xee, 0xf7, 0xa6, 0xed, 0xfb, 0x66, 0x4b, 0x32, 0xe0, 0x6d, 0x9f, 0x86, 0xe5, 0x04, 0xfe, 0x30,
+	0xef, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xac, 0x8d, 0x1e, 0xd7, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -222,9 +745,169 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 	Metadata: "celestia/blob/v1/query.proto",
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+// BlobQueryClient is the client API for BlobQuery service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobQueryClient interface {
+	// BlobsByNamespace returns all the blobs that were published to a namespace
+	// at a given height, or all the blobs published at that height if the
+	// namespace is empty.
+	BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error)
+	// BlobByCommitment returns the blob that was published to a namespace at a
+	// given height and that matches the provided share commitment.
+	BlobByCommitment(ctx context.Context, in *QueryBlobByCommitmentRequest, opts ...grpc.CallOption) (*QueryBlobByCommitmentResponse, error)
+	// CommitmentProof returns a proof of the share commitment of the blob that
+	// was published to a namespace at a given height. The proof can be verified
+	// against the data root without the shares of the blob.
+	CommitmentProof(ctx context.Context, in *QueryCommitmentProofRequest, opts ...grpc.CallOption) (*QueryCommitmentProofResponse, error)
+}
+
+type blobQueryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobQueryClient(cc grpc1.ClientConn) BlobQueryClient {
+	return &blobQueryClient{cc}
+}
+
+func (c *blobQueryClient) BlobsByNamespace(ctx context.Context, in *QueryBlobsByNamespaceRequest, opts ...grpc.CallOption) (*QueryBlobsByNamespaceResponse, error) {
+	out := new(QueryBlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobQuery/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobQueryClient) BlobByCommitment(ctx context.Context, in *QueryBlobByCommitmentRequest, opts ...grpc.CallOption) (*QueryBlobByCommitmentResponse, error) {
+	out := new(QueryBlobByCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobQuery/BlobByCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobQueryClient) CommitmentProof(ctx context.Context, in *QueryCommitmentProofRequest, opts ...grpc.CallOption) (*QueryCommitmentProofResponse, error) {
+	out := new(QueryCommitmentProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobQuery/CommitmentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobQueryServer is the server API for BlobQuery service.
+type BlobQueryServer interface {
+	// BlobsByNamespace returns all the blobs that were published to a namespace
+	// at a given height, or all the blobs published at that height if the
+	// namespace is empty.
+	BlobsByNamespace(context.Context, *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error)
+	// BlobByCommitment returns the blob that was published to a namespace at a
+	// given height and that matches the provided share commitment.
+	BlobByCommitment(context.Context, *QueryBlobByCommitmentRequest) (*QueryBlobByCommitmentResponse, error)
+	// CommitmentProof returns a proof of the share commitment of the blob that
+	// was published to a namespace at a given height. The proof can be verified
+	// against the data root without the shares of the blob.
+	CommitmentProof(context.Context, *QueryCommitmentProofRequest) (*QueryCommitmentProofResponse, error)
+}
+
+// UnimplementedBlobQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobQueryServer struct {
+}
+
+func (*UnimplementedBlobQueryServer) BlobsByNamespace(ctx context.Context, req *QueryBlobsByNamespaceRequest) (*QueryBlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+func (*UnimplementedBlobQueryServer) BlobByCommitment(ctx context.Context, req *QueryBlobByCommitmentRequest) (*QueryBlobByCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobByCommitment not implemented")
+}
+func (*UnimplementedBlobQueryServer) CommitmentProof(ctx context.Context, req *QueryCommitmentProofRequest) (*QueryCommitmentProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitmentProof not implemented")
+}
+
+func RegisterBlobQueryServer(s grpc1.Server, srv BlobQueryServer) {
+	s.RegisterService(&_BlobQuery_serviceDesc, srv)
+}
+
+func _BlobQuery_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobQueryServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobQuery/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobQueryServer).BlobsByNamespace(ctx, req.(*QueryBlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobQuery_BlobByCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobByCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobQueryServer).BlobByCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobQuery/BlobByCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobQueryServer).BlobByCommitment(ctx, req.(*QueryBlobByCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobQuery_CommitmentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobQueryServer).CommitmentProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobQuery/CommitmentProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobQueryServer).CommitmentProof(ctx, req.(*QueryCommitmentProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlobQuery_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.BlobQuery",
+	HandlerType: (*BlobQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _BlobQuery_BlobsByNamespace_Handler,
+		},
+		{
+			MethodName: "BlobByCommitment",
+			Handler:    _BlobQuery_BlobByCommitment_Handler,
+		},
+		{
+			MethodName: "CommitmentProof",
+			Handler:    _BlobQuery_CommitmentProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -270,52 +953,1284 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobByCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobByCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobByCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prove {
+		i--
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobByCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobByCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobByCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Blob.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishedBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishedBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishedBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareProof) > 0 {
+		i -= len(m.ShareProof)
+		copy(dAtA[i:], m.ShareProof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareProof)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ShareEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ShareCommitment) > 0 {
+		i -= len(m.ShareCommitment)
+		copy(dAtA[i:], m.ShareCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ShareCommitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blob != nil {
+		{
+			size, err := m.Blob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlobByCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBlobByCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Blob.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryCommitmentProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PublishedBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blob != nil {
+		l = m.Blob.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ShareCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BlobIndex))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovQuery(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovQuery(uint64(m.ShareEnd))
+	}
+	l = len(m.ShareProof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, PublishedBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobByCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobByCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobByCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobByCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobByCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobByCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Blob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCommitmentProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -338,12 +2253,65 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCommitmentProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCommitmentProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -365,7 +2333,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *PublishedBlob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -388,15 +2356,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishedBlob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishedBlob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -423,10 +2391,189 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Blob == nil {
+				m.Blob = &blob.Blob{}
+			}
+			if err := m.Blob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareCommitment = append(m.ShareCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareCommitment == nil {
+				m.ShareCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
+			}
+			m.ShareStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
+			}
+			m.ShareEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareProof = append(m.ShareProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ShareProof == nil {
+				m.ShareProof = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9,12 +9,15 @@ import (
 	"github.com/celestiaorg/celestia-app/x/blobstream/keeper"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
 func TestFirstAttestationIsValset(t *testing.T) {
@@ -201,7 +204,7 @@ func TestGetDataCommitment(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// set the data commitment window
-			qk.SetParams(ctx, types.Params{DataCommitmentWindow: tt.window})
+			qk.SetParams(ctx, paramsWithWindow(tt.window))
 			require.Equal(t, tt.window, qk.GetDataCommitmentWindowParam(ctx))
 
 			// change the block height
@@ -296,7 +299,7 @@ func TestHasDataCommitmentInStore(t *testing.T) {
 	input, ctx := testutil.SetupFiveValChain(t)
 	qk := input.BlobstreamKeeper
 	// set the data commitment window
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 400})
+	qk.SetParams(ctx, paramsWithWindow(400))
 	require.Equal(t, uint64(400), qk.GetDataCommitmentWindowParam(ctx))
 
 	tests := []struct {
@@ -361,19 +364,19 @@ func TestDataCommitmentCreationCatchup(t *testing.T) {
 	ctx = ctx.WithBlockHeight(1)
 
 	// from height 1 to 1500 with a window of 400
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 400})
+	qk.SetParams(ctx, paramsWithWindow(400))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1, 1501)
 
 	// change window to 100 and execute up to 1920
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 100})
+	qk.SetParams(ctx, paramsWithWindow(100))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1501, 1921)
 
 	// change window to 1000 and execute up to 3500
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 1000})
+	qk.SetParams(ctx, paramsWithWindow(1000))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 1921, 3501)
 
 	// change window to 111 and execute up to 3800
-	qk.SetParams(ctx, types.Params{DataCommitmentWindow: 111})
+	qk.SetParams(ctx, paramsWithWindow(111))
 	ctx = testutil.ExecuteBlobstreamHeights(ctx, qk, 3501, 3801)
 
 	// check if a data commitment was created
@@ -524,7 +527,7 @@ func TestPruning(t *testing.T) {
 	bsKeeper := input.BlobstreamKeeper
 	// set the data commitment window
 	window := uint64(101)
-	bsKeeper.SetParams(ctx, types.Params{DataCommitmentWindow: window})
+	bsKeeper.SetParams(ctx, paramsWithWindow(window))
 	initialBlockTime := ctx.BlockTime()
 	blockInterval := 10 * time.Minute
 	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1626, blockInterval)
@@ -558,7 +561,7 @@ func TestPruning(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, found)
 		// make sure the remaining attestations have not expired yet
-		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(blobstream.AttestationExpiryTime)))
+		assert.True(t, initialBlockTime.Before(at.BlockTime().Add(bsKeeper.GetAttestationExpiryTimeParam(ctx))))
 	}
 
 	// check that no valset exists in store
@@ -579,3 +582,151 @@ func TestPruning(t *testing.T) {
 	// inconsistency happens after pruning
 	testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 5000, 6000, blockInterval)
 }
+
+func TestDataRootPruning(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	window := uint64(101)
+	bsKeeper.SetParams(ctx, paramsWithWindow(window))
+	blockTime := ctx.BlockTime()
+	for height := int64(1); height < 5000; height++ {
+		header := ctx.BlockHeader()
+		header.Height = height
+		header.Time = blockTime
+		header.DataHash = tmrand.Bytes(types.DataRootSize)
+		header.Version.App = v2.Version
+		ctx = ctx.WithBlockHeader(header)
+		blobstream.EndBlocker(ctx, bsKeeper)
+		blockTime = blockTime.Add(10 * time.Minute)
+	}
+
+	earliestNonce := bsKeeper.GetEarliestAvailableAttestationNonce(ctx)
+	require.Greater(t, earliestNonce, uint64(1))
+
+	// the data roots of the pruned data commitments were deleted
+	earliestDC, err := bsKeeper.GetDataCommitmentByNonce(ctx, earliestNonce)
+	require.NoError(t, err)
+	for height := uint64(1); height < earliestDC.BeginBlock; height++ {
+		_, found := bsKeeper.GetDataRoot(ctx, height)
+		assert.False(t, found)
+	}
+
+	// the data root tuple roots of the remaining data commitments can still be
+	// computed
+	for nonce := earliestNonce; nonce <= bsKeeper.GetLatestAttestationNonce(ctx); nonce++ {
+		_, _, err := bsKeeper.GetDataRootTupleRoot(ctx, nonce)
+		assert.NoError(t, err)
+	}
+}
+
+func TestDataRootNotSavedBeforeV2(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	header := ctx.BlockHeader()
+	header.DataHash = tmrand.Bytes(types.DataRootSize)
+	ctx = ctx.WithBlockHeader(header)
+	blobstream.EndBlocker(ctx, bsKeeper)
+
+	_, found := bsKeeper.GetDataRoot(ctx, uint64(ctx.BlockHeight()))
+	assert.False(t, found)
+	_, found = bsKeeper.GetDataRootsStartHeight(ctx)
+	assert.False(t, found)
+}
+
+func TestAttestationConfirmPruning(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	bsKeeper.SetParams(ctx, paramsWithWindow(101))
+	header := ctx.BlockHeader()
+	header.Version.App = v2.Version
+	ctx = ctx.WithBlockHeader(header)
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 10, time.Minute)
+
+	confirm := types.AttestationConfirm{Nonce: 1, ValidatorAddress: testutil.ValAddrs[0].String()}
+	require.NoError(t, bsKeeper.SetAttestationConfirm(ctx, confirm))
+
+	// pruning the first attestation prunes its confirms
+	expiry := bsKeeper.GetAttestationExpiryTimeParam(ctx)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(expiry))
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 10, 120, time.Minute)
+	require.Greater(t, bsKeeper.GetEarliestAvailableAttestationNonce(ctx), uint64(1))
+
+	confirms, err := bsKeeper.GetAttestationConfirms(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, confirms)
+}
+
+// paramsWithWindow returns the default params with the provided data
+// commitment window.
+func paramsWithWindow(window uint64) types.Params {
+	params := types.DefaultParams()
+	params.DataCommitmentWindow = window
+	return params
+}
+
+func TestValsetCreationWithSignificantPowerDifferenceThresholdParam(t *testing.T) {
+	tests := []struct {
+		name           string
+		threshold      sdk.Dec
+		expectedValset bool
+	}{
+		{"default threshold", types.DefaultSignificantPowerDifferenceThreshold, true},
+		{"maximum threshold", sdk.OneDec(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, ctx := testutil.SetupFiveValChain(t)
+			pk := input.BlobstreamKeeper
+			params := types.DefaultParams()
+			params.SignificantPowerDifferenceThreshold = tt.threshold
+			pk.SetParams(ctx, params)
+
+			ctx = ctx.WithBlockHeight(1)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+			currentAttestationNonce := pk.GetLatestAttestationNonce(ctx)
+			require.Equal(t, uint64(1), currentAttestationNonce)
+
+			// undelegating half of the stake of a validator changes the power of
+			// the validator set without the validator unbonding
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			msgServer := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
+			undelegateMsg := testutil.NewTestMsgUnDelegateValidator(testutil.ValAddrs[0], testutil.StakingAmount.QuoRaw(2))
+			_, err := msgServer.Undelegate(ctx, undelegateMsg)
+			require.NoError(t, err)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+
+			if tt.expectedValset {
+				assert.Equal(t, currentAttestationNonce+1, pk.GetLatestAttestationNonce(ctx))
+			} else {
+				assert.Equal(t, currentAttestationNonce, pk.GetLatestAttestationNonce(ctx))
+			}
+		})
+	}
+}
+
+func TestPruningWithAttestationExpiryTimeParam(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	params := paramsWithWindow(101)
+	params.AttestationExpiryTime = 24 * time.Hour
+	bsKeeper.SetParams(ctx, params)
+	blockInterval := 10 * time.Minute
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1626, blockInterval)
+
+	// with a one day expiry time, only the attestations created in the last
+	// day are kept
+	earliestNonce := bsKeeper.GetEarliestAvailableAttestationNonce(ctx)
+	assert.Greater(t, earliestNonce, uint64(1))
+	for nonce := earliestNonce; nonce <= bsKeeper.GetLatestAttestationNonce(ctx); nonce++ {
+		at, found, err := bsKeeper.GetAttestationByNonce(ctx, nonce)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.True(t, ctx.BlockTime().Before(at.BlockTime().Add(params.AttestationExpiryTime)))
+	}
+	previous, found, err := bsKeeper.GetAttestationByNonce(ctx, earliestNonce-1)
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Nil(t, previous)
+}
@@ -0,0 +1,272 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// ProofBundle contains everything needed to verify, without network access,
+// that a range of shares was committed to by the validators signing
+// attestations for the Blobstream contract.
+type ProofBundle struct {
+	// Height is the height of the block containing the shares.
+	Height uint64 `json:"height"`
+	// DataRoot is the data root of the block containing the shares.
+	DataRoot tmbytes.HexBytes `json:"data_root"`
+	// ShareProof proves the inclusion of the shares in the data root.
+	ShareProof coretypes.ShareProof `json:"share_proof"`
+	// DataCommitment is the data commitment whose range contains the height.
+	DataCommitment types.DataCommitment `json:"data_commitment"`
+	// DataRootTupleRoot is the data root tuple root of the data commitment.
+	DataRootTupleRoot tmbytes.HexBytes `json:"data_root_tuple_root"`
+	// DataRootInclusionProof proves the inclusion of the (height, data root)
+	// tuple in the data root tuple root.
+	DataRootInclusionProof merkle.Proof `json:"data_root_inclusion_proof"`
+	// Valset is the validator set that the data commitment is checked against
+	// by the Blobstream contract.
+	Valset types.Valset `json:"valset"`
+	// Signatures are the signatures of the valset members over the data
+	// commitment.
+	Signatures []types.AttestationConfirm `json:"signatures"`
+}
+
+// ExportProofBundle queries the proofs, the data commitment, its valset and
+// the submitted signatures needed to verify the provided range of shares
+// offline.
+func ExportProofBundle(ctx context.Context, logger tmlog.Logger, config VerifyConfig, height uint64, startShare uint64, endShare uint64) (ProofBundle, error) {
+	trpc, err := http.New(config.TendermintRPC, "/websocket")
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	err = trpc.Start()
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	defer func(trpc *http.HTTP) {
+		err := trpc.Stop()
+		if err != nil {
+			logger.Debug("error closing connection", "err", err.Error())
+		}
+	}(trpc)
+
+	logger.Debug("getting shares proof from tendermint node")
+	sharesProof, err := trpc.ProveShares(ctx, height, startShare, endShare)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+
+	bsGRPC, err := grpc.Dial(config.CelesGRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	defer func(bsGRPC *grpc.ClientConn) {
+		err := bsGRPC.Close()
+		if err != nil {
+			logger.Debug("error closing connection", "err", err.Error())
+		}
+	}(bsGRPC)
+
+	queryClient := types.NewQueryClient(bsGRPC)
+
+	dcResp, err := queryClient.DataCommitmentRangeForHeight(
+		ctx,
+		&types.QueryDataCommitmentRangeForHeightRequest{Height: height},
+	)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	nonce := dcResp.DataCommitment.Nonce
+
+	logger.Debug("getting the data root to commitment inclusion proof", "nonce", nonce)
+	proofResp, err := queryClient.DataRootTupleInclusionProof(
+		ctx,
+		&types.QueryDataRootTupleInclusionProofRequest{Nonce: nonce, Height: height},
+	)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	dcProof, err := merkle.ProofFromProto(&proofResp.Proof)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+
+	logger.Debug("getting the data commitment signatures", "nonce", nonce)
+	powerResp, err := queryClient.AttestationConfirmPower(
+		ctx,
+		&types.QueryAttestationConfirmPowerRequest{Nonce: nonce},
+	)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	confirmsResp, err := queryClient.AttestationConfirms(
+		ctx,
+		&types.QueryAttestationConfirmsRequest{Nonce: nonce},
+	)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	vsResp, err := queryClient.AttestationRequestByNonce(
+		ctx,
+		&types.QueryAttestationRequestByNonceRequest{Nonce: powerResp.ValsetNonce},
+	)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	if vsResp.Attestation == nil {
+		return ProofBundle{}, types.ErrNilAttestation
+	}
+	att, err := unmarshallAttestation(vsResp.Attestation)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	vs, ok := att.(*types.Valset)
+	if !ok {
+		return ProofBundle{}, types.ErrAttestationNotValsetRequest
+	}
+
+	return ProofBundle{
+		Height:                 height,
+		DataRoot:               proofResp.DataRoot,
+		ShareProof:             sharesProof,
+		DataCommitment:         *proofResp.DataCommitment,
+		DataRootTupleRoot:      proofResp.DataRootTupleRoot,
+		DataRootInclusionProof: *dcProof,
+		Valset:                 *vs,
+		Signatures:             confirmsResp.Confirms,
+	}, nil
+}
+
+// VerifyProofBundle verifies, without network access, that the shares of the
+// bundle were committed to by the valset of the bundle. That is, that the
+// shares are included in the data root, that the data root is included in the
+// data root tuple root of the data commitment, and that the valset members
+// that signed the data commitment hold at least the power threshold of the
+// valset. The valset must match the trusted valset checkpoint, e.g. the
+// checkpoint stored in the Blobstream contract, as anyone can forge a bundle
+// with a valset of their own that signs it. The check can only be skipped, for
+// testing, by setting insecureSkipValsetCheck with an empty checkpoint.
+func VerifyProofBundle(logger tmlog.Logger, bundle ProofBundle, trustedValsetCheckpoint ethcmn.Hash, insecureSkipValsetCheck bool) (bool, error) {
+	switch {
+	case trustedValsetCheckpoint == (ethcmn.Hash{}) && !insecureSkipValsetCheck:
+		return false, fmt.Errorf("a trusted valset checkpoint is required to verify the valset of the bundle")
+	case trustedValsetCheckpoint != (ethcmn.Hash{}) && insecureSkipValsetCheck:
+		return false, fmt.Errorf("can't both skip the valset check and provide a trusted valset checkpoint")
+	case insecureSkipValsetCheck:
+		logger.Info("skipping the valset check: the bundle is only checked to be consistent with its own valset")
+	}
+
+	logger.Info("verifying shares inclusion to data root", "height", bundle.Height)
+	if err := bundle.ShareProof.Validate(bundle.DataRoot); err != nil {
+		logger.Info("proofs from shares to data root are invalid", "err", err.Error())
+		return false, nil
+	}
+
+	dc := bundle.DataCommitment
+	logger.Info(
+		"verifying data root inclusion to data root tuple root",
+		"nonce",
+		dc.Nonce,
+		"first_block",
+		dc.BeginBlock,
+		"last_block",
+		dc.EndBlock,
+	)
+	if bundle.Height < dc.BeginBlock || bundle.Height >= dc.EndBlock {
+		return false, fmt.Errorf("height %d is not in the data commitment range [%d, %d)", bundle.Height, dc.BeginBlock, dc.EndBlock)
+	}
+	tuple, err := types.EncodeDataRootTuple(bundle.Height, bundle.DataRoot)
+	if err != nil {
+		return false, err
+	}
+	if err := bundle.DataRootInclusionProof.Verify(bundle.DataRootTupleRoot, tuple); err != nil {
+		logger.Info("proof from data root to data root tuple root is invalid", "err", err.Error())
+		return false, nil
+	}
+
+	checkpoint, err := bundle.Valset.SignBytes()
+	if err != nil {
+		return false, err
+	}
+	if !insecureSkipValsetCheck && checkpoint != trustedValsetCheckpoint {
+		return false, fmt.Errorf("valset checkpoint %s doesn't match the trusted checkpoint %s", checkpoint.Hex(), trustedValsetCheckpoint.Hex())
+	}
+
+	logger.Info(
+		"verifying data commitment signatures",
+		"valset_nonce",
+		bundle.Valset.Nonce,
+		"valset_checkpoint",
+		checkpoint.Hex(),
+	)
+	digest, err := types.DataCommitmentSignBytes(dc.Nonce, bundle.DataRootTupleRoot)
+	if err != nil {
+		return false, err
+	}
+	powers := make(map[ethcmn.Address]uint64, len(bundle.Valset.Members))
+	for _, member := range bundle.Valset.Members {
+		powers[ethcmn.HexToAddress(member.EvmAddress)] = member.Power
+	}
+	power := uint64(0)
+	for _, signature := range bundle.Signatures {
+		signer, err := types.RecoverEVMAddress(digest, signature.Signature)
+		if err != nil {
+			logger.Debug("ignoring invalid signature", "validator", signature.ValidatorAddress, "err", err.Error())
+			continue
+		}
+		memberPower, isMember := powers[signer]
+		if !isMember {
+			logger.Debug("ignoring signature of a non valset member", "validator", signature.ValidatorAddress, "signer", signer.Hex())
+			continue
+		}
+		// a member's power only counts once
+		delete(powers, signer)
+		power += memberPower
+	}
+
+	threshold := bundle.Valset.TwoThirdsThreshold()
+	if power < threshold {
+		logger.Info("the data commitment signatures don't reach the power threshold", "power", power, "threshold", threshold)
+		return false, nil
+	}
+
+	logger.Info("the valset has committed to the provided shares", "power", power, "threshold", threshold)
+	return true, nil
+}
+
+// WriteProofBundle writes the JSON encoded proof bundle to the provided file.
+func WriteProofBundle(path string, bundle ProofBundle) error {
+	bz, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, bz, 0o600)
+}
+
+// ReadProofBundle reads a JSON encoded proof bundle from the provided file.
+// Unknown fields are rejected.
+func ReadProofBundle(path string) (ProofBundle, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return ProofBundle{}, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	var bundle ProofBundle
+	if err := decoder.Decode(&bundle); err != nil {
+		return ProofBundle{}, err
+	}
+	return bundle, nil
+}
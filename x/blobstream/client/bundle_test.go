@@ -0,0 +1,195 @@
+package client_test
+
+import (
+	"crypto/ecdsa"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	appns "github.com/celestiaorg/celestia-app/pkg/namespace"
+	"github.com/celestiaorg/celestia-app/pkg/proof"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestVerifyProofBundle(t *testing.T) {
+	bundle, keys := generateProofBundle(t)
+	logger := tmlog.NewNopLogger()
+
+	// the bundle survives a round trip through a file
+	path := filepath.Join(t.TempDir(), "bundle.json")
+	require.NoError(t, client.WriteProofBundle(path, bundle))
+	bundle, err := client.ReadProofBundle(path)
+	require.NoError(t, err)
+
+	checkpoint, err := bundle.Valset.SignBytes()
+	require.NoError(t, err)
+	valid, err := client.VerifyProofBundle(logger, bundle, checkpoint, false)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	_, err = client.VerifyProofBundle(logger, bundle, crypto.Keccak256Hash([]byte("checkpoint")), false)
+	assert.Error(t, err)
+
+	// a trusted checkpoint is required unless the check is explicitly skipped
+	_, err = client.VerifyProofBundle(logger, bundle, ethcmn.Hash{}, false)
+	assert.Error(t, err)
+	_, err = client.VerifyProofBundle(logger, bundle, checkpoint, true)
+	assert.Error(t, err)
+	valid, err = client.VerifyProofBundle(logger, bundle, ethcmn.Hash{}, true)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	t.Run("forged valset", func(t *testing.T) {
+		// a valset of the forger's own keys signs the data commitment
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		forged := bundle
+		vs, err := types.NewValset(1, 1, types.InternalBridgeValidators{{Power: 100, EVMAddress: crypto.PubkeyToAddress(key.PublicKey)}}, time.Now())
+		require.NoError(t, err)
+		forged.Valset = *vs
+		forged.Signatures = []types.AttestationConfirm{signDataCommitment(t, forged, key)}
+
+		_, err = client.VerifyProofBundle(logger, forged, checkpoint, false)
+		assert.Error(t, err)
+		// which is why the valset check can't be skipped implicitly
+		valid, err := client.VerifyProofBundle(logger, forged, ethcmn.Hash{}, true)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+
+	t.Run("tampered shares", func(t *testing.T) {
+		tampered := bundle
+		tampered.ShareProof.Data = [][]byte{tmrand.Bytes(appconsts.ShareSize)}
+		valid, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("tampered data root tuple root", func(t *testing.T) {
+		tampered := bundle
+		tampered.DataRootTupleRoot = tmrand.Bytes(32)
+		valid, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("height outside of the data commitment", func(t *testing.T) {
+		tampered := bundle
+		tampered.Height = bundle.DataCommitment.EndBlock
+		_, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("signatures below the threshold", func(t *testing.T) {
+		tampered := bundle
+		tampered.Signatures = bundle.Signatures[:2]
+		valid, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("duplicate signatures only count once", func(t *testing.T) {
+		tampered := bundle
+		tampered.Signatures = append(bundle.Signatures[:2:2], bundle.Signatures[0], bundle.Signatures[1])
+		valid, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.False(t, valid)
+	})
+
+	t.Run("signatures of non members are ignored", func(t *testing.T) {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		tampered := bundle
+		tampered.Signatures = append(bundle.Signatures[:2:2], signDataCommitment(t, bundle, key))
+		valid, err := client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.False(t, valid)
+
+		tampered.Signatures = append(tampered.Signatures, signDataCommitment(t, bundle, keys[2]))
+		valid, err = client.VerifyProofBundle(logger, tampered, checkpoint, false)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	})
+}
+
+// generateProofBundle generates a valid proof bundle for the first transaction
+// of a random block at height 3, signed by three out of four equally powered
+// valset members.
+func generateProofBundle(t *testing.T) (client.ProofBundle, []*ecdsa.PrivateKey) {
+	txs := testfactory.GenerateRandomTxs(10, 100).ToSliceOfBytes()
+	builder, err := square.NewBuilder(appconsts.DefaultSquareSizeUpperBound, appconsts.LatestVersion, txs...)
+	require.NoError(t, err)
+	dataSquare, err := builder.Export()
+	require.NoError(t, err)
+	shareRange, err := builder.FindTxShareRange(0)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	shareProof, err := proof.NewShareInclusionProofFromEDS(eds, dah, appns.TxNamespace, shareRange)
+	require.NoError(t, err)
+
+	height := uint64(3)
+	dc := types.NewDataCommitment(2, 1, 6, time.Now())
+	tuples := make([][]byte, 0, dc.EndBlock-dc.BeginBlock)
+	for h := dc.BeginBlock; h < dc.EndBlock; h++ {
+		dataRoot := tmrand.Bytes(types.DataRootSize)
+		if h == height {
+			dataRoot = dah.Hash()
+		}
+		tuple, err := types.EncodeDataRootTuple(h, dataRoot)
+		require.NoError(t, err)
+		tuples = append(tuples, tuple)
+	}
+	root, proofs := merkle.ProofsFromByteSlices(tuples)
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	members := make(types.InternalBridgeValidators, len(keys))
+	for i := range keys {
+		keys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		members[i] = &types.InternalBridgeValidator{Power: 100, EVMAddress: crypto.PubkeyToAddress(keys[i].PublicKey)}
+	}
+	vs, err := types.NewValset(1, 1, members, time.Now())
+	require.NoError(t, err)
+
+	bundle := client.ProofBundle{
+		Height:                 height,
+		DataRoot:               dah.Hash(),
+		ShareProof:             shareProof,
+		DataCommitment:         *dc,
+		DataRootTupleRoot:      root,
+		DataRootInclusionProof: *proofs[height-dc.BeginBlock],
+		Valset:                 *vs,
+	}
+	for _, key := range keys[:3] {
+		bundle.Signatures = append(bundle.Signatures, signDataCommitment(t, bundle, key))
+	}
+	return bundle, keys
+}
+
+func signDataCommitment(t *testing.T, bundle client.ProofBundle, key *ecdsa.PrivateKey) types.AttestationConfirm {
+	digest, err := types.DataCommitmentSignBytes(bundle.DataCommitment.Nonce, bundle.DataRootTupleRoot)
+	require.NoError(t, err)
+	signature, err := crypto.Sign(accounts.TextHash(digest.Bytes()), key)
+	require.NoError(t, err)
+	return types.AttestationConfirm{
+		Nonce:      bundle.DataCommitment.Nonce,
+		EvmAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Signature:  signature,
+	}
+}
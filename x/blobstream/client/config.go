@@ -6,14 +6,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 )
 
 const (
-	evmChainIDFlag      = "evm-chain-id"
-	celesGRPCFlag       = "celes-grpc"
-	evmRPCFlag          = "evm-rpc"
-	contractAddressFlag = "contract-address"
+	evmChainIDFlag              = "evm-chain-id"
+	celesGRPCFlag               = "celes-grpc"
+	evmRPCFlag                  = "evm-rpc"
+	contractAddressFlag         = "contract-address"
+	outputFlag                  = "output"
+	valsetCheckpointFlag        = "valset-checkpoint"
+	insecureSkipValsetCheckFlag = "insecure-skip-valset-check"
 )
 
 func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
@@ -76,3 +80,63 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 		ContractAddr:    address,
 	}, nil
 }
+
+func addExportFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringP(flags.FlagNode, "t", "http://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().StringP(outputFlag, "o", "bundle.json", "The file to write the proof bundle to")
+
+	return cmd
+}
+
+func parseExportFlags(cmd *cobra.Command) (VerifyConfig, string, error) {
+	tendermintRPC, err := cmd.Flags().GetString(flags.FlagNode)
+	if err != nil {
+		return VerifyConfig{}, "", err
+	}
+	celesGRPC, err := cmd.Flags().GetString(celesGRPCFlag)
+	if err != nil {
+		return VerifyConfig{}, "", err
+	}
+	output, err := cmd.Flags().GetString(outputFlag)
+	if err != nil {
+		return VerifyConfig{}, "", err
+	}
+
+	return VerifyConfig{
+		CelesGRPC:     celesGRPC,
+		TendermintRPC: tendermintRPC,
+	}, output, nil
+}
+
+func addBundleFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(valsetCheckpointFlag, "", "The hex encoded trusted validator set checkpoint, e.g. from the Blobstream contract, that the bundle valset must match")
+	cmd.Flags().Bool(insecureSkipValsetCheckFlag, false, "Skip checking the bundle valset against a trusted checkpoint. Anyone can forge a bundle that passes verification without it")
+
+	return cmd
+}
+
+func parseBundleFlags(cmd *cobra.Command) (ethcmn.Hash, bool, error) {
+	checkpoint, err := cmd.Flags().GetString(valsetCheckpointFlag)
+	if err != nil {
+		return ethcmn.Hash{}, false, err
+	}
+	skipValsetCheck, err := cmd.Flags().GetBool(insecureSkipValsetCheckFlag)
+	if err != nil {
+		return ethcmn.Hash{}, false, err
+	}
+	if checkpoint == "" {
+		if !skipValsetCheck {
+			return ethcmn.Hash{}, false, fmt.Errorf("valid valset checkpoint flag is required: %s, or %s to skip the valset check", valsetCheckpointFlag, insecureSkipValsetCheckFlag)
+		}
+		return ethcmn.Hash{}, true, nil
+	}
+	if skipValsetCheck {
+		return ethcmn.Hash{}, false, fmt.Errorf("only one of %s and %s can be set", valsetCheckpointFlag, insecureSkipValsetCheckFlag)
+	}
+	bz, err := hexutil.Decode(checkpoint)
+	if err != nil || len(bz) != ethcmn.HashLength {
+		return ethcmn.Hash{}, false, fmt.Errorf("valid valset checkpoint flag is required: %s", valsetCheckpointFlag)
+	}
+	return ethcmn.BytesToHash(bz), false, nil
+}
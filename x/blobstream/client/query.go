@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,7 +25,16 @@ func GetQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryAttestationByNonce(), CmdQueryEVMAddress())
+	cmd.AddCommand(
+		CmdQueryAttestationByNonce(),
+		CmdQueryAttestations(),
+		CmdQueryAttestationNonceRangeForTime(),
+		CmdQueryAttestationConfirms(),
+		CmdQueryAttestationConfirmPower(),
+		CmdQueryEVMAddress(),
+		CmdQueryEVMAddressAtHeight(),
+		CmdQueryEVMAddressHistory(),
+	)
 
 	return cmd
 }
@@ -76,6 +86,184 @@ func CmdQueryAttestationByNonce() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagBeginNonce      = "begin-nonce"
+	FlagEndNonce        = "end-nonce"
+	FlagAttestationType = "type"
+)
+
+// attestationTypes maps the values of the attestation type flag to the
+// attestation types.
+var attestationTypes = map[string]types.AttestationType{
+	"":                types.AttestationType_ATTESTATION_TYPE_UNSPECIFIED,
+	"valset":          types.AttestationType_ATTESTATION_TYPE_VALSET,
+	"data-commitment": types.AttestationType_ATTESTATION_TYPE_DATA_COMMITMENT,
+}
+
+func CmdQueryAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "attestations",
+		Aliases: []string{"atts"},
+		Short:   "query the attestations in a nonce range",
+		Long: "Query the attestations with nonces in the inclusive range defined by the begin and end nonce flags, " +
+			"optionally filtered by type. The range defaults to all the available attestations.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			beginNonce, err := cmd.Flags().GetUint64(FlagBeginNonce)
+			if err != nil {
+				return err
+			}
+			endNonce, err := cmd.Flags().GetUint64(FlagEndNonce)
+			if err != nil {
+				return err
+			}
+			typeFlag, err := cmd.Flags().GetString(FlagAttestationType)
+			if err != nil {
+				return err
+			}
+			attestationType, ok := attestationTypes[typeFlag]
+			if !ok {
+				return fmt.Errorf("unknown attestation type %q: expected valset or data-commitment", typeFlag)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Attestations(
+				cmd.Context(),
+				&types.QueryAttestationsRequest{
+					BeginNonce: beginNonce,
+					EndNonce:   endNonce,
+					Type:       attestationType,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagBeginNonce, 0, "nonce of the first attestation, defaults to the earliest available attestation")
+	cmd.Flags().Uint64(FlagEndNonce, 0, "nonce of the last attestation, defaults to the latest attestation")
+	cmd.Flags().String(FlagAttestationType, "", "type of the attestations: valset or data-commitment, defaults to both")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+
+	return cmd
+}
+
+func CmdQueryAttestationNonceRangeForTime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce-range <start_time> <end_time>",
+		Short: "query the nonces of the earliest and latest attestations created in a time range",
+		Long: "Query the nonces of the earliest and latest attestations created in the end exclusive time range " +
+			"[start_time, end_time). The times are in RFC3339 format, e.g. 2023-01-02T15:04:05Z.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, err := time.Parse(time.RFC3339, args[0])
+			if err != nil {
+				return err
+			}
+			endTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AttestationNonceRangeForTime(
+				cmd.Context(),
+				&types.QueryAttestationNonceRangeForTimeRequest{StartTime: startTime, EndTime: endTime},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAttestationConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirms <nonce>",
+		Short: "query the signatures submitted for an attestation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AttestationConfirms(
+				cmd.Context(),
+				&types.QueryAttestationConfirmsRequest{Nonce: nonce},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAttestationConfirmPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-power <nonce>",
+		Short: "query the power accumulated by the signatures submitted for an attestation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.AttestationConfirmPower(
+				cmd.Context(),
+				&types.QueryAttestationConfirmPowerRequest{Nonce: nonce},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryEVMAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "evm <validator_valoper_address>",
@@ -105,6 +293,66 @@ func CmdQueryEVMAddress() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryEVMAddressAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-at-height <validator_valoper_address> <height>",
+		Short: "query the evm address that corresponded to a validator bech32 valoper address at a height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.EVMAddressAtHeight(
+				cmd.Context(),
+				&types.QueryEVMAddressAtHeightRequest{ValidatorAddress: args[0], Height: height},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryEVMAddressHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-history <validator_valoper_address>",
+		Short: "query the history of the evm addresses of a validator bech32 valoper address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EVMAddressHistory(
+				cmd.Context(),
+				&types.QueryEVMAddressHistoryRequest{ValidatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // unmarshallAttestation unmarshal a wrapper protobuf `Any` type to an `AttestationRequestI`.
 func unmarshallAttestation(attestation *codectypes.Any) (types.AttestationRequestI, error) {
 	var unmarshalledAttestation types.AttestationRequestI
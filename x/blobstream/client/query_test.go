@@ -1,10 +1,17 @@
 package client_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 )
 
 func (s *CLITestSuite) TestQueryAttestationByNonce() {
@@ -57,3 +64,189 @@ func (s *CLITestSuite) TestQueryAttestationByNonce() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryAttestationCommands() {
+	_, err := s.network.WaitForHeight(402)
+	s.Require().NoError(err)
+	val := s.network.Validators[0]
+
+	// attestations decodes the output of the attestations command into the
+	// attestations and the pagination of the response.
+	attestations := func(t *testing.T, out []byte) ([]types.AttestationRequestI, *query.PageResponse) {
+		var resp types.QueryAttestationsResponse
+		require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(out, &resp))
+		ats := make([]types.AttestationRequestI, len(resp.Attestations))
+		for i, any := range resp.Attestations {
+			require.NoError(t, val.ClientCtx.InterfaceRegistry.UnpackAny(any, &ats[i]))
+		}
+		return ats, resp.Pagination
+	}
+	nonces := func(ats []types.AttestationRequestI) []uint64 {
+		nonces := make([]uint64, len(ats))
+		for i, at := range ats {
+			nonces[i] = at.GetNonce()
+		}
+		return nonces
+	}
+
+	// the chain starts with a single validator that never changes, so its
+	// first attestations are the valset of the genesis and a data commitment
+	testCases := []struct {
+		name      string
+		cmd       func() *cobra.Command
+		args      []string
+		expectErr bool
+		check     func(t *testing.T, out []byte)
+	}{
+		{
+			name: "attestations in nonce range",
+			cmd:  client.CmdQueryAttestations,
+			args: []string{"--begin-nonce=1", "--end-nonce=2"},
+			check: func(t *testing.T, out []byte) {
+				ats, page := attestations(t, out)
+				require.Equal(t, []uint64{1, 2}, nonces(ats))
+				assert.IsType(t, &types.Valset{}, ats[0])
+				assert.IsType(t, &types.DataCommitment{}, ats[1])
+				assert.Nil(t, page.NextKey)
+			},
+		},
+		{
+			name: "data commitments in nonce range",
+			cmd:  client.CmdQueryAttestations,
+			args: []string{"--begin-nonce=1", "--end-nonce=2", "--type=data-commitment"},
+			check: func(t *testing.T, out []byte) {
+				ats, _ := attestations(t, out)
+				assert.Equal(t, []uint64{2}, nonces(ats))
+			},
+		},
+		{
+			name: "valsets",
+			cmd:  client.CmdQueryAttestations,
+			args: []string{"--type=valset"},
+			check: func(t *testing.T, out []byte) {
+				ats, _ := attestations(t, out)
+				assert.Equal(t, []uint64{1}, nonces(ats))
+			},
+		},
+		{
+			name: "paginated attestations",
+			cmd:  client.CmdQueryAttestations,
+			args: []string{"--end-nonce=2", "--limit=1", "--count-total"},
+			check: func(t *testing.T, out []byte) {
+				ats, page := attestations(t, out)
+				assert.Equal(t, []uint64{1}, nonces(ats))
+				assert.Equal(t, types.UInt64Bytes(2), page.NextKey)
+				assert.EqualValues(t, 2, page.Total)
+			},
+		},
+		{
+			name: "next page of attestations",
+			cmd:  client.CmdQueryAttestations,
+			args: []string{"--end-nonce=2", "--limit=1", "--page-key=" + string(types.UInt64Bytes(2))},
+			check: func(t *testing.T, out []byte) {
+				ats, page := attestations(t, out)
+				assert.Equal(t, []uint64{2}, nonces(ats))
+				assert.Nil(t, page.NextKey)
+			},
+		},
+		{
+			name:      "unknown attestation type",
+			cmd:       client.CmdQueryAttestations,
+			args:      []string{"--type=unknown"},
+			expectErr: true,
+		},
+		{
+			name:      "begin nonce higher than end nonce",
+			cmd:       client.CmdQueryAttestations,
+			args:      []string{"--begin-nonce=2", "--end-nonce=1"},
+			expectErr: true,
+		},
+		{
+			name: "nonce range of all the attestations",
+			cmd:  client.CmdQueryAttestationNonceRangeForTime,
+			args: []string{"2000-01-01T00:00:00Z", "3000-01-01T00:00:00Z"},
+			check: func(t *testing.T, out []byte) {
+				var resp types.QueryAttestationNonceRangeForTimeResponse
+				require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(out, &resp))
+				assert.EqualValues(t, 1, resp.BeginNonce)
+				assert.GreaterOrEqual(t, resp.EndNonce, uint64(2))
+			},
+		},
+		{
+			name:      "no attestation in time range",
+			cmd:       client.CmdQueryAttestationNonceRangeForTime,
+			args:      []string{"2000-01-01T00:00:00Z", "2000-01-02T00:00:00Z"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid time",
+			cmd:       client.CmdQueryAttestationNonceRangeForTime,
+			args:      []string{"yesterday", "3000-01-01T00:00:00Z"},
+			expectErr: true,
+		},
+		{
+			name: "confirm power of an unsigned data commitment",
+			cmd:  client.CmdQueryAttestationConfirmPower,
+			args: []string{"2"},
+			check: func(t *testing.T, out []byte) {
+				var resp types.QueryAttestationConfirmPowerResponse
+				require.NoError(t, val.ClientCtx.Codec.UnmarshalJSON(out, &resp))
+				// no orchestrator is signing the attestations
+				assert.Zero(t, resp.Power)
+				assert.Positive(t, resp.Threshold)
+				assert.False(t, resp.ThresholdReached)
+				assert.EqualValues(t, 1, resp.ValsetNonce)
+			},
+		},
+		{
+			name:      "confirm power of an unknown nonce",
+			cmd:       client.CmdQueryAttestationConfirmPower,
+			args:      []string{"100000"},
+			expectErr: true,
+		},
+		{
+			name:      "confirm power of an invalid nonce",
+			cmd:       client.CmdQueryAttestationConfirmPower,
+			args:      []string{"nonce"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, tc.cmd(), append(tc.args, "--output=json"))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, out.Bytes())
+		})
+	}
+}
+
+func (s *CLITestSuite) TestExportProofBundle() {
+	_, err := s.network.WaitForHeight(402)
+	s.Require().NoError(err)
+	val := s.network.Validators[0]
+
+	bundle, err := client.ExportProofBundle(
+		context.Background(),
+		tmlog.NewNopLogger(),
+		client.VerifyConfig{TendermintRPC: val.RPCAddress, CelesGRPC: val.AppConfig.GRPC.Address},
+		10,
+		0,
+		1,
+	)
+	s.Require().NoError(err)
+	s.Assert().Equal(uint64(10), bundle.Height)
+	s.Assert().Equal(uint64(1), bundle.Valset.Nonce)
+
+	// no orchestrator is signing the attestations so only the proofs are valid
+	checkpoint, err := bundle.Valset.SignBytes()
+	s.Require().NoError(err)
+	valid, err := client.VerifyProofBundle(tmlog.NewNopLogger(), bundle, checkpoint, false)
+	s.Require().NoError(err)
+	s.Assert().False(valid)
+	s.Assert().NoError(bundle.ShareProof.Validate(bundle.DataRoot))
+}
@@ -4,8 +4,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/celestiaorg/celestia-app/app"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/test/util/network"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	cosmosnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/stretchr/testify/suite"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
@@ -29,6 +32,14 @@ func (s *CLITestSuite) SetupSuite() {
 	cfg.MinGasPrices = "0utia"
 	cfg.NumValidators = 1
 	cfg.TimeoutCommit = time.Millisecond
+	// celestia-core does not pass the app version of the genesis to InitChain
+	// so the app is started at v2 for the data roots to be saved.
+	appConstructor := cfg.AppConstructor
+	cfg.AppConstructor = func(val cosmosnet.Validator) servertypes.Application {
+		a := appConstructor(val)
+		a.(*app.App).SetProtocolVersion(v2.Version)
+		return a
+	}
 	s.cfg = cfg
 
 	numAccounts := 120
@@ -2,11 +2,13 @@ package client
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/spf13/cobra"
 )
 
@@ -20,7 +22,7 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdRegisterEVMAddress())
+	cmd.AddCommand(CmdRegisterEVMAddress(), CmdSubmitAttestationSignature())
 
 	return cmd
 }
@@ -57,3 +59,44 @@ send a new message overriding the previous one.
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdSubmitAttestationSignature() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [valAddress] [nonce] [signature]",
+		Short: "Submit a validator's signature over an attestation",
+		Long: `Submits the hex encoded [R || S || V] EVM signature of a validator over the
+EIP-191 signed message hash of the digest of the attestation with the provided nonce.
+The signature must be produced by the EVM address registered by the validator. Only
+the validator, as the signer, can submit its signature.
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			signature, err := hexutil.Decode(args[2])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSubmitAttestationSignature{
+				ValidatorAddress: args[0],
+				Nonce:            nonce,
+				Signature:        signature,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
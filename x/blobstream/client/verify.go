@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"os"
 	"strconv"
@@ -30,6 +31,8 @@ func VerifyCmd() *cobra.Command {
 		txCmd(),
 		sharesCmd(),
 		blobCmd(),
+		exportCmd(),
+		bundleCmd(),
 	)
 	return command
 }
@@ -186,6 +189,77 @@ func sharesCmd() *cobra.Command {
 	return addVerifyFlags(command)
 }
 
+func exportCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "export <height> <start_share> <end_share>",
+		Args:  cobra.ExactArgs(3),
+		Short: "Exports a self-contained proof bundle for a range of shares that can be verified offline. The range should be end exclusive.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return err
+			}
+			startShare, err := strconv.ParseUint(args[1], 10, 0)
+			if err != nil {
+				return err
+			}
+			endShare, err := strconv.ParseUint(args[2], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			config, output, err := parseExportFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger := tmlog.NewTMLogger(os.Stdout)
+
+			bundle, err := ExportProofBundle(cmd.Context(), logger, config, height, startShare, endShare)
+			if err != nil {
+				return err
+			}
+			if err := WriteProofBundle(output, bundle); err != nil {
+				return err
+			}
+			logger.Info("exported proof bundle", "output", output, "nonce", bundle.DataCommitment.Nonce, "signatures", len(bundle.Signatures))
+			return nil
+		},
+	}
+	return addExportFlags(command)
+}
+
+func bundleCmd() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "bundle <bundle_file>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Verifies, without network access, that the shares of a proof bundle exported by the export command were committed to by the Blobstream validator set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			trustedCheckpoint, skipValsetCheck, err := parseBundleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			bundle, err := ReadProofBundle(args[0])
+			if err != nil {
+				return err
+			}
+
+			logger := tmlog.NewTMLogger(os.Stdout)
+
+			isCommittedTo, err := VerifyProofBundle(logger, bundle, trustedCheckpoint, skipValsetCheck)
+			if err != nil {
+				return err
+			}
+			if !isCommittedTo {
+				return fmt.Errorf("the proof bundle %s is invalid", args[0])
+			}
+			return nil
+		},
+	}
+	return addBundleFlags(command)
+}
+
 func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig, height uint64, startShare uint64, endShare uint64) (isCommittedTo bool, err error) {
 	trpc, err := http.New(config.TendermintRPC, "/websocket")
 	if err != nil {
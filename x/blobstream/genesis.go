@@ -25,11 +25,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// which is executed on every block.
 	k.SetEarliestAvailableAttestationNonce(ctx, InitialEarliestAvailableAttestationNonce)
 	k.SetParams(ctx, *genState.Params)
+	// the genesis validators are given their default EVM address by the
+	// staking genesis before the app version is set in the block headers, so
+	// they are indexed here when the chain starts at v2.
+	if keeper.IsV2(ctx) {
+		k.IndexEVMAddresses(ctx)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
-	genesis.Params.DataCommitmentWindow = k.GetDataCommitmentWindowParam(ctx)
+	params := k.GetParams(ctx)
+	genesis.Params = &params
 	return genesis
 }
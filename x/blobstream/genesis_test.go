@@ -0,0 +1,79 @@
+package blobstream_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/app"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/x/blobstream"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestGenesisParams(t *testing.T) {
+	input := testutil.CreateTestEnvWithoutBlobstreamKeysInit(t)
+	params := types.Params{
+		DataCommitmentWindow:                200,
+		AttestationExpiryTime:               24 * time.Hour,
+		SignificantPowerDifferenceThreshold: sdk.NewDecWithPrec(1, 1),
+	}
+	blobstream.InitGenesis(input.Context, input.BlobstreamKeeper, types.GenesisState{Params: &params})
+
+	assert.Equal(t, params.AttestationExpiryTime, input.BlobstreamKeeper.GetAttestationExpiryTimeParam(input.Context))
+	assert.Equal(t, params.SignificantPowerDifferenceThreshold, input.BlobstreamKeeper.GetSignificantPowerDifferenceThresholdParam(input.Context))
+	assert.Equal(t, &types.GenesisState{Params: &params}, blobstream.ExportGenesis(input.Context, input.BlobstreamKeeper))
+}
+
+func TestGenesisIndexesEVMAddressesAtV2(t *testing.T) {
+	// setupGenesis creates the genesis validators, which are given their
+	// default EVM address, and initializes the Blobstream genesis.
+	setupGenesis := func(appVersion uint64) (testutil.TestInput, sdk.Context) {
+		input := testutil.CreateTestEnvWithoutBlobstreamKeysInit(t)
+		input.StakingKeeper.SetParams(input.Context, testutil.TestingStakeParams)
+		for i := range []int{0, 1} {
+			testutil.CreateValidator(t, input, testutil.AccAddrs[i], testutil.AccPubKeys[i], uint64(i), testutil.ValAddrs[i], testutil.ConsPubKeys[i], testutil.StakingAmount)
+		}
+		header := input.Context.BlockHeader()
+		header.Version.App = appVersion
+		ctx := input.Context.WithBlockHeader(header)
+		blobstream.InitGenesis(ctx, input.BlobstreamKeeper, *types.DefaultGenesis())
+		return input, ctx
+	}
+	evmAddr := types.DefaultEVMAddress(testutil.ValAddrs[0])
+
+	// on a chain starting at v1, the EVM addresses of the genesis validators
+	// are only indexed by the migration to v2
+	input, ctx := setupGenesis(v1.Version)
+	_, found := input.BlobstreamKeeper.GetValidatorByEVMAddress(ctx, evmAddr)
+	assert.False(t, found)
+
+	input, ctx = setupGenesis(v2.Version)
+	valAddr, found := input.BlobstreamKeeper.GetValidatorByEVMAddress(ctx, evmAddr)
+	require.True(t, found)
+	assert.Equal(t, testutil.ValAddrs[0], valAddr)
+	// so another validator can't register the default EVM address of a
+	// genesis validator
+	_, err := input.BlobstreamKeeper.RegisterEVMAddress(ctx, types.NewMsgRegisterEVMAddress(testutil.ValAddrs[1], evmAddr))
+	assert.ErrorIs(t, err, types.ErrEVMAddressAlreadyExists)
+}
+
+func TestInitChainIndexesEVMAddressesAtV2(t *testing.T) {
+	cparams := app.DefaultConsensusParams()
+	cparams.Version.AppVersion = v2.Version
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(cparams)
+	ctx := testApp.NewContext(true, tmproto.Header{})
+
+	validators := testApp.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	evmAddr, found := testApp.BlobstreamKeeper.GetEVMAddress(ctx, validators[0].GetOperator())
+	require.True(t, found)
+	valAddr, found := testApp.BlobstreamKeeper.GetValidatorByEVMAddress(ctx, evmAddr)
+	require.True(t, found)
+	assert.Equal(t, validators[0].GetOperator(), valAddr)
+}
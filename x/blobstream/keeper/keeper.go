@@ -3,7 +3,9 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"time"
 
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -21,6 +23,13 @@ type Keeper struct {
 	StakingKeeper StakingKeeper
 }
 
+// IsV2 returns true if the block being processed runs app version 2 or later.
+// The store is migrated to its latest consensus version when the chain
+// upgrades to v2, so the state added since can only be relied on from then.
+func IsV2(ctx sdk.Context) bool {
+	return ctx.BlockHeader().Version.App >= v2.Version
+}
+
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, stakingKeeper StakingKeeper) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -35,12 +44,31 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 	}
 }
 
-// GetParams returns the parameters from the store
+// GetParams returns the parameters from the store. The parameters that are not
+// in store, because they were added after the chain started and haven't been
+// migrated yet, have their default values.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
+// GetAttestationExpiryTimeParam returns the attestation expiry time param, or
+// its default value if it is not in store yet.
+func (k Keeper) GetAttestationExpiryTimeParam(ctx sdk.Context) time.Duration {
+	expiryTime := types.DefaultAttestationExpiryTime
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeyAttestationExpiryTime, &expiryTime)
+	return expiryTime
+}
+
+// GetSignificantPowerDifferenceThresholdParam returns the significant power
+// difference threshold param, or its default value if it is not in store yet.
+func (k Keeper) GetSignificantPowerDifferenceThresholdParam(ctx sdk.Context) sdk.Dec {
+	threshold := types.DefaultSignificantPowerDifferenceThreshold
+	k.paramSpace.GetIfExists(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold, &threshold)
+	return threshold
+}
+
 // SetParams sets the parameters in the store
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
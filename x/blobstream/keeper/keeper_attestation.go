@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
+	"cosmossdk.io/errors"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -137,3 +140,59 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	}
 	store.Delete(key)
 }
+
+// GetAttestationNonceRangeForTime returns the nonces of the earliest and latest
+// available attestations created in the end exclusive time range [startTime,
+// endTime). Since attestations are created in order, their block times
+// increase with their nonces, which allows to binary search them.
+func (k Keeper) GetAttestationNonceRangeForTime(ctx sdk.Context, startTime, endTime time.Time) (uint64, uint64, error) {
+	if !endTime.After(startTime) {
+		return 0, 0, errors.Wrap(types.ErrInvalidTimeRange, fmt.Sprintf("end time %s is not after start time %s", endTime, startTime))
+	}
+	if !k.CheckLatestAttestationNonce(ctx) {
+		return 0, 0, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return 0, 0, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	earliestNonce := k.GetEarliestAvailableAttestationNonce(ctx)
+	latestNonce := k.GetLatestAttestationNonce(ctx)
+	if latestNonce < earliestNonce {
+		return 0, 0, errors.Wrap(types.ErrAttestationNotFound, "no attestation in store")
+	}
+
+	// searchNonce returns the nonce of the earliest attestation created at or
+	// after t, or latestNonce+1 if there is none.
+	var searchErr error
+	searchNonce := func(t time.Time) uint64 {
+		i := sort.Search(int(latestNonce-earliestNonce+1), func(i int) bool {
+			if searchErr != nil {
+				return true
+			}
+			nonce := earliestNonce + uint64(i)
+			at, found, err := k.GetAttestationByNonce(ctx, nonce)
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			if !found {
+				searchErr = errors.Wrap(types.ErrAttestationNotFound, fmt.Sprintf("nonce %d", nonce))
+				return true
+			}
+			return !at.BlockTime().Before(t)
+		})
+		return earliestNonce + uint64(i)
+	}
+	beginNonce := searchNonce(startTime)
+	endNonce := searchNonce(endTime)
+	if searchErr != nil {
+		return 0, 0, searchErr
+	}
+	if beginNonce == endNonce {
+		return 0, 0, errors.Wrap(
+			types.ErrAttestationNotFound,
+			fmt.Sprintf("no attestation created between %s and %s", startTime, endTime),
+		)
+	}
+	return beginNonce, endNonce - 1, nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// GetAttestation returns the attestation with the provided nonce. Returns an
+// error if the attestation was pruned or doesn't exist.
+func (k Keeper) GetAttestation(ctx sdk.Context, nonce uint64) (types.AttestationRequestI, error) {
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if nonce < k.GetEarliestAvailableAttestationNonce(ctx) {
+		return nil, types.ErrRequestedNonceWasPruned
+	}
+	at, found, err := k.GetAttestationByNonce(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.Wrap(types.ErrAttestationNotFound, fmt.Sprintf("nonce %d", nonce))
+	}
+	return at, nil
+}
+
+// GetAttestationSignBytes returns the digest that validators are required to
+// sign over for the provided attestation.
+func (k Keeper) GetAttestationSignBytes(ctx sdk.Context, at types.AttestationRequestI) (gethcommon.Hash, error) {
+	switch at := at.(type) {
+	case *types.Valset:
+		return at.SignBytes()
+	case *types.DataCommitment:
+		_, root, err := k.GetDataRootTupleRoot(ctx, at.Nonce)
+		if err != nil {
+			return gethcommon.Hash{}, err
+		}
+		return types.DataCommitmentSignBytes(at.Nonce, root)
+	default:
+		return gethcommon.Hash{}, errors.Wrap(types.ErrUnknownAttestationType, fmt.Sprintf("nonce %d", at.GetNonce()))
+	}
+}
+
+// GetSigningValset returns the validator set that the attestation with the
+// provided nonce is checked against by the Blobstream contract, i.e. the latest
+// valset before the nonce. The first valset is the one the contract is
+// initialized with, so it is checked against itself.
+func (k Keeper) GetSigningValset(ctx sdk.Context, nonce uint64) (*types.Valset, error) {
+	if nonce != 1 {
+		return k.GetLatestValsetBeforeNonce(ctx, nonce)
+	}
+	at, err := k.GetAttestation(ctx, nonce)
+	if err != nil {
+		return nil, err
+	}
+	vs, ok := at.(*types.Valset)
+	if !ok {
+		return nil, errors.Wrap(types.ErrAttestationNotValsetRequest, fmt.Sprintf("nonce %d", nonce))
+	}
+	return vs, nil
+}
+
+// SetAttestationConfirm saves the attestation confirm in store.
+func (k Keeper) SetAttestationConfirm(ctx sdk.Context, confirm types.AttestationConfirm) error {
+	valAddr, err := sdk.ValAddressFromBech32(confirm.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	b, err := k.cdc.Marshal(&confirm)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.GetAttestationConfirmKey(confirm.Nonce, valAddr)), b)
+	return nil
+}
+
+// GetAttestationConfirm returns the confirm of the provided validator for the
+// attestation with the provided nonce. Returns (empty, false, nil) if the
+// confirm is not found.
+func (k Keeper) GetAttestationConfirm(ctx sdk.Context, nonce uint64, valAddr sdk.ValAddress) (types.AttestationConfirm, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.GetAttestationConfirmKey(nonce, valAddr)))
+	if bz == nil {
+		return types.AttestationConfirm{}, false, nil
+	}
+	var confirm types.AttestationConfirm
+	if err := k.cdc.Unmarshal(bz, &confirm); err != nil {
+		return types.AttestationConfirm{}, false, err
+	}
+	return confirm, true, nil
+}
+
+// GetAttestationConfirms returns the confirms submitted for the attestation
+// with the provided nonce, ordered by validator address.
+func (k Keeper) GetAttestationConfirms(ctx sdk.Context, nonce uint64) ([]types.AttestationConfirm, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.GetAttestationConfirmsKey(nonce)))
+	defer iterator.Close()
+	confirms := make([]types.AttestationConfirm, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var confirm types.AttestationConfirm
+		if err := k.cdc.Unmarshal(iterator.Value(), &confirm); err != nil {
+			return nil, err
+		}
+		confirms = append(confirms, confirm)
+	}
+	return confirms, nil
+}
+
+// GetAttestationConfirmPower returns the power accumulated by the confirms of
+// the attestation with the provided nonce, along with the signing valset that
+// the power is checked against.
+func (k Keeper) GetAttestationConfirmPower(ctx sdk.Context, nonce uint64) (uint64, *types.Valset, error) {
+	if _, err := k.GetAttestation(ctx, nonce); err != nil {
+		return 0, nil, err
+	}
+	vs, err := k.GetSigningValset(ctx, nonce)
+	if err != nil {
+		return 0, nil, err
+	}
+	confirms, err := k.GetAttestationConfirms(ctx, nonce)
+	if err != nil {
+		return 0, nil, err
+	}
+	power := uint64(0)
+	for _, confirm := range confirms {
+		power += confirm.Power
+	}
+	return power, vs, nil
+}
+
+// DeleteAttestationConfirms deletes the confirms of the attestation with the
+// provided nonce from state.
+func (k Keeper) DeleteAttestationConfirms(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.GetAttestationConfirmsKey(nonce)))
+	keys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
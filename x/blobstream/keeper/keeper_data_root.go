@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// SetDataRoot saves the data root of the block at height in store. The height
+// of the first data root saved is recorded as the height from which data roots
+// are available.
+func (k Keeper) SetDataRoot(ctx sdk.Context, height uint64, dataRoot []byte) error {
+	if len(dataRoot) != types.DataRootSize {
+		return errors.Wrap(types.ErrInvalidDataRoot, fmt.Sprintf("height %d: size %d", height, len(dataRoot)))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has([]byte(types.DataRootsStartHeight)) {
+		store.Set([]byte(types.DataRootsStartHeight), types.UInt64Bytes(height))
+	}
+	store.Set([]byte(types.GetDataRootKey(height)), dataRoot)
+	return nil
+}
+
+// GetDataRootsStartHeight returns the height of the first block whose data
+// root was saved. The data roots are only saved from app version 2, so this
+// is the first v2 height on chains upgraded from v1. Returns (0, false) if no
+// data root was saved yet.
+func (k Keeper) GetDataRootsStartHeight(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get([]byte(types.DataRootsStartHeight))
+	if bytes == nil {
+		return 0, false
+	}
+	return UInt64FromBytes(bytes), true
+}
+
+// GetDataRoot returns the data root of the block at height. Returns (nil,
+// false) if the data root is not found.
+func (k Keeper) GetDataRoot(ctx sdk.Context, height uint64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	dataRoot := store.Get([]byte(types.GetDataRootKey(height)))
+	if dataRoot == nil {
+		return nil, false
+	}
+	return dataRoot, true
+}
+
+// DeleteDataRoots deletes the data roots of the blocks in the end exclusive
+// range [beginBlock, endBlock) from state.
+func (k Keeper) DeleteDataRoots(ctx sdk.Context, beginBlock, endBlock uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for height := beginBlock; height < endBlock; height++ {
+		store.Delete([]byte(types.GetDataRootKey(height)))
+	}
+}
+
+// GetDataCommitmentByNonce returns the data commitment with the provided
+// nonce.
+func (k Keeper) GetDataCommitmentByNonce(ctx sdk.Context, nonce uint64) (types.DataCommitment, error) {
+	if !k.CheckEarliestAvailableAttestationNonce(ctx) {
+		return types.DataCommitment{}, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if nonce < k.GetEarliestAvailableAttestationNonce(ctx) {
+		return types.DataCommitment{}, types.ErrRequestedNonceWasPruned
+	}
+	att, found, err := k.GetAttestationByNonce(ctx, nonce)
+	if err != nil {
+		return types.DataCommitment{}, err
+	}
+	if !found {
+		return types.DataCommitment{}, errors.Wrap(types.ErrAttestationNotFound, fmt.Sprintf("nonce %d", nonce))
+	}
+	dc, ok := att.(*types.DataCommitment)
+	if !ok {
+		return types.DataCommitment{}, errors.Wrap(types.ErrAttestationNotDataCommitment, fmt.Sprintf("nonce %d", nonce))
+	}
+	return *dc, nil
+}
+
+// GetDataRootTupleRoot returns the data root tuple root of the data commitment
+// with the provided nonce. It is computed the same way as the data commitment
+// returned by the Tendermint RPC, i.e. as the root of the RFC-6962 Merkle tree
+// over the encoded (height, data root) tuples of the data commitment range.
+func (k Keeper) GetDataRootTupleRoot(ctx sdk.Context, nonce uint64) (types.DataCommitment, []byte, error) {
+	dc, err := k.GetDataCommitmentByNonce(ctx, nonce)
+	if err != nil {
+		return types.DataCommitment{}, nil, err
+	}
+	tuples, err := k.encodedDataRootTuples(ctx, dc)
+	if err != nil {
+		return types.DataCommitment{}, nil, err
+	}
+	return dc, merkle.HashFromByteSlices(tuples), nil
+}
+
+// GetDataRootTupleInclusionProof returns a Merkle proof of the inclusion of
+// the (height, data root) tuple of the block at height in the data root tuple
+// root of the data commitment with the provided nonce. It also returns the
+// data root tuple root and the data root of the block at height.
+func (k Keeper) GetDataRootTupleInclusionProof(
+	ctx sdk.Context,
+	nonce uint64,
+	height uint64,
+) (dc types.DataCommitment, root []byte, dataRoot []byte, proof *merkle.Proof, err error) {
+	dc, err = k.GetDataCommitmentByNonce(ctx, nonce)
+	if err != nil {
+		return types.DataCommitment{}, nil, nil, nil, err
+	}
+	if height < dc.BeginBlock || height >= dc.EndBlock {
+		return types.DataCommitment{}, nil, nil, nil, errors.Wrap(
+			types.ErrHeightNotInDataCommitment,
+			fmt.Sprintf("height %d not in [%d, %d)", height, dc.BeginBlock, dc.EndBlock),
+		)
+	}
+	tuples, err := k.encodedDataRootTuples(ctx, dc)
+	if err != nil {
+		return types.DataCommitment{}, nil, nil, nil, err
+	}
+	root, proofs := merkle.ProofsFromByteSlices(tuples)
+	dataRoot, _ = k.GetDataRoot(ctx, height)
+	return dc, root, dataRoot, proofs[height-dc.BeginBlock], nil
+}
+
+// encodedDataRootTuples returns the encoded (height, data root) tuples of the
+// blocks in the range of the provided data commitment. The data commitments
+// whose range starts before the data roots were recorded, i.e. that cover
+// heights before the upgrade to v2, never have all their data roots in store
+// so an ErrDataRootsNotRecorded error is returned for them.
+func (k Keeper) encodedDataRootTuples(ctx sdk.Context, dc types.DataCommitment) ([][]byte, error) {
+	if dc.EndBlock <= dc.BeginBlock {
+		return nil, errors.Wrap(types.ErrDataCommitmentNotFound, fmt.Sprintf("empty range [%d, %d)", dc.BeginBlock, dc.EndBlock))
+	}
+	startHeight, found := k.GetDataRootsStartHeight(ctx)
+	if !found {
+		return nil, errors.Wrap(types.ErrDataRootsNotRecorded, fmt.Sprintf("range [%d, %d): no data root was recorded yet", dc.BeginBlock, dc.EndBlock))
+	}
+	if dc.BeginBlock < startHeight {
+		return nil, errors.Wrap(types.ErrDataRootsNotRecorded, fmt.Sprintf("range [%d, %d) starts before the data roots were recorded from height %d", dc.BeginBlock, dc.EndBlock, startHeight))
+	}
+	tuples := make([][]byte, 0, dc.EndBlock-dc.BeginBlock)
+	for height := dc.BeginBlock; height < dc.EndBlock; height++ {
+		dataRoot, found := k.GetDataRoot(ctx, height)
+		if !found {
+			return nil, errors.Wrap(types.ErrDataRootNotFound, fmt.Sprintf("height %d", height))
+		}
+		tuple, err := types.EncodeDataRootTuple(height, dataRoot)
+		if err != nil {
+			return nil, err
+		}
+		tuples = append(tuples, tuple)
+	}
+	return tuples, nil
+}
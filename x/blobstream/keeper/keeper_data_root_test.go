@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func TestDataRootTupleRoot(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	dc := types.NewDataCommitment(2, 1, 11, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+
+	// compute the expected tuples the same way as the Tendermint RPC
+	expectedTuples := make([][]byte, 0, dc.EndBlock-dc.BeginBlock)
+	for height := dc.BeginBlock; height < dc.EndBlock; height++ {
+		dataRoot := tmrand.Bytes(types.DataRootSize)
+		require.NoError(t, k.SetDataRoot(sdkCtx, height, dataRoot))
+		tuple, err := core.EncodeDataRootTuple(height, *(*[32]byte)(dataRoot))
+		require.NoError(t, err)
+		expectedTuples = append(expectedTuples, tuple)
+	}
+	expectedRoot := merkle.HashFromByteSlices(expectedTuples)
+
+	gotDC, root, err := k.GetDataRootTupleRoot(sdkCtx, dc.Nonce)
+	require.NoError(t, err)
+	assert.Equal(t, *dc, gotDC)
+	assert.Equal(t, expectedRoot, root)
+
+	for height := dc.BeginBlock; height < dc.EndBlock; height++ {
+		_, root, dataRoot, proof, err := k.GetDataRootTupleInclusionProof(sdkCtx, dc.Nonce, height)
+		require.NoError(t, err)
+		assert.Equal(t, expectedRoot, root)
+		assert.Equal(t, expectedTuples[height-dc.BeginBlock][32:], dataRoot)
+		assert.NoError(t, proof.Verify(root, expectedTuples[height-dc.BeginBlock]))
+	}
+
+	// the tuple root can't be computed for a valset
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, 1)
+	assert.ErrorIs(t, err, types.ErrAttestationNotDataCommitment)
+	// the tuple root can't be computed for a nonce that doesn't exist
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, 3)
+	assert.ErrorIs(t, err, types.ErrAttestationNotFound)
+	// the height must be in the data commitment range
+	_, _, _, _, err = k.GetDataRootTupleInclusionProof(sdkCtx, dc.Nonce, dc.EndBlock)
+	assert.ErrorIs(t, err, types.ErrHeightNotInDataCommitment)
+	// all the data roots of the range must be in store
+	k.DeleteDataRoots(sdkCtx, dc.EndBlock-1, dc.EndBlock)
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, dc.Nonce)
+	assert.ErrorIs(t, err, types.ErrDataRootNotFound)
+}
+
+func TestDataRootTupleRootBeforeUpgrade(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	before := types.NewDataCommitment(2, 1, 11, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, before))
+
+	// no tuple root is produced before the data roots are recorded
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, before.Nonce)
+	assert.ErrorIs(t, err, types.ErrDataRootsNotRecorded)
+
+	// the data roots are recorded from the upgrade height on
+	for height := uint64(6); height < 21; height++ {
+		require.NoError(t, k.SetDataRoot(sdkCtx, height, tmrand.Bytes(types.DataRootSize)))
+	}
+	startHeight, found := k.GetDataRootsStartHeight(sdkCtx)
+	require.True(t, found)
+	assert.EqualValues(t, 6, startHeight)
+
+	// a data commitment covering heights before the upgrade never produces a
+	// tuple root
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, before.Nonce)
+	assert.ErrorIs(t, err, types.ErrDataRootsNotRecorded)
+	after := types.NewDataCommitment(3, 11, 21, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, after))
+	_, _, err = k.GetDataRootTupleRoot(sdkCtx, after.Nonce)
+	assert.NoError(t, err)
+}
+
+func TestSetDataRootInvalidSize(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	err := input.BlobstreamKeeper.SetDataRoot(sdkCtx, 1, tmrand.Bytes(types.DataRootSize-1))
+	assert.ErrorIs(t, err, types.ErrInvalidDataRoot)
+}
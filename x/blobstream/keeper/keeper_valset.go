@@ -205,9 +205,58 @@ func (k Keeper) GetLatestValsetBeforeNonce(ctx sdk.Context, nonce uint64) (*type
 	)
 }
 
+// SetEVMAddress sets the EVM address of the validator. From app version 2, it
+// keeps the reverse index from EVM address to validator up to date, records
+// the change in the validator's EVM address history at the current height,
+// and emits a rotation event. These are built for the addresses set before by
+// the migration to v2, or by the genesis of a chain starting at v2, see
+// IndexEVMAddresses.
 func (k Keeper) SetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress, evmAddress gethcommon.Address) {
 	store := ctx.KVStore(k.storeKey)
+	if !IsV2(ctx) {
+		store.Set(types.GetEVMKey(valAddress), evmAddress.Bytes())
+		return
+	}
+	previous, exists := k.GetEVMAddress(ctx, valAddress)
+	if exists && previous == evmAddress {
+		return
+	}
+	if exists {
+		store.Delete(types.GetValidatorByEVMAddressKey(previous))
+	}
 	store.Set(types.GetEVMKey(valAddress), evmAddress.Bytes())
+	k.setValidatorByEVMAddress(ctx, evmAddress, valAddress)
+	k.setEVMAddressRecord(ctx, valAddress, uint64(ctx.BlockHeight()), evmAddress)
+
+	previousHex := ""
+	if exists {
+		previousHex = previous.Hex()
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEVMAddressRotation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousEVMAddress, previousHex),
+			sdk.NewAttribute(types.AttributeKeyEVMAddress, evmAddress.Hex()),
+		),
+	)
+}
+
+// IndexEVMAddresses builds the reverse index from EVM address to validator and
+// starts the EVM address history of every validator with its current EVM
+// address, for the addresses set before app version 2. As the height at which
+// these addresses were set is unknown, they are recorded at height 0.
+func (k Keeper) IndexEVMAddresses(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.EVMAddress))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		valAddress := sdk.ValAddress(iterator.Key()[len(types.EVMAddress):])
+		evmAddress := gethcommon.BytesToAddress(iterator.Value())
+		k.setValidatorByEVMAddress(ctx, evmAddress, valAddress)
+		k.setEVMAddressRecord(ctx, valAddress, 0, evmAddress)
+	}
 }
 
 func (k Keeper) GetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress) (gethcommon.Address, bool) {
@@ -219,11 +268,27 @@ func (k Keeper) GetEVMAddress(ctx sdk.Context, valAddress sdk.ValAddress) (gethc
 	return gethcommon.BytesToAddress(addrBytes), true
 }
 
+// GetValidatorByEVMAddress returns the validator that the provided EVM address
+// is currently registered to.
+func (k Keeper) GetValidatorByEVMAddress(ctx sdk.Context, evmAddress gethcommon.Address) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	valAddress := store.Get(types.GetValidatorByEVMAddressKey(evmAddress))
+	if valAddress == nil {
+		return nil, false
+	}
+	return valAddress, true
+}
+
 // IsEVMAddressUnique checks if the provided evm address is globally unique. This
 // includes the defaults we set validators when they initially create a validator
-// before registering
+// before registering. The reverse index from EVM address to validator is only
+// complete once the store was migrated, when upgrading to v2, so the EVM
+// addresses are scanned until then.
 func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Address) bool {
 	store := ctx.KVStore(k.storeKey)
+	if IsV2(ctx) {
+		return !store.Has(types.GetValidatorByEVMAddressKey(evmAddress))
+	}
 	addrBytes := evmAddress.Bytes()
 	iterator := sdk.KVStorePrefixIterator(store, []byte(types.EVMAddress))
 	defer iterator.Close()
@@ -234,3 +299,50 @@ func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Addres
 	}
 	return true
 }
+
+// GetEVMAddressAtHeight returns the EVM address that was set for the validator
+// at the provided height, along with the height at which it was set.
+func (k Keeper) GetEVMAddressAtHeight(ctx sdk.Context, valAddress sdk.ValAddress, height uint64) (types.EVMAddressRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	// the end of the iterator is exclusive, so it is set right after the key
+	// of the provided height.
+	iterator := store.ReverseIterator(
+		types.GetEVMAddressHistoryPrefix(valAddress),
+		sdk.PrefixEndBytes(types.GetEVMAddressHistoryKey(valAddress, height)),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.EVMAddressRecord{}, false
+	}
+	return evmAddressRecord(valAddress, iterator.Key(), iterator.Value()), true
+}
+
+// GetEVMAddressHistory returns the history of the EVM addresses of the
+// validator ordered by height.
+func (k Keeper) GetEVMAddressHistory(ctx sdk.Context, valAddress sdk.ValAddress) []types.EVMAddressRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetEVMAddressHistoryPrefix(valAddress))
+	defer iterator.Close()
+	records := make([]types.EVMAddressRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, evmAddressRecord(valAddress, iterator.Key(), iterator.Value()))
+	}
+	return records
+}
+
+func (k Keeper) setValidatorByEVMAddress(ctx sdk.Context, evmAddress gethcommon.Address, valAddress sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorByEVMAddressKey(evmAddress), valAddress)
+}
+
+func (k Keeper) setEVMAddressRecord(ctx sdk.Context, valAddress sdk.ValAddress, height uint64, evmAddress gethcommon.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEVMAddressHistoryKey(valAddress, height), evmAddress.Bytes())
+}
+
+// evmAddressRecord decodes an entry of the EVM address history of the
+// validator.
+func evmAddressRecord(valAddress sdk.ValAddress, key, value []byte) types.EVMAddressRecord {
+	height := UInt64FromBytes(key[len(types.GetEVMAddressHistoryPrefix(valAddress)):])
+	return types.EVMAddressRecord{Height: height, EvmAddress: gethcommon.BytesToAddress(value).Hex()}
+}
@@ -3,12 +3,15 @@ package keeper_test
 import (
 	"bytes"
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream"
 
 	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/x/blobstream/keeper"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -225,3 +228,75 @@ func TestEVMAddresses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "", resp.EvmAddress)
 }
+
+func TestEVMAddressRotation(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	valAddr := testutil.ValAddrs[0]
+	initialEVMAddress, exists := k.GetEVMAddress(ctx, valAddr)
+	require.True(t, exists)
+	initialHeight := uint64(ctx.BlockHeight())
+
+	firstEVMAddress := gethcommon.BytesToAddress([]byte("first"))
+	secondEVMAddress := gethcommon.BytesToAddress([]byte("second"))
+	// the addresses set at v1 are indexed by the migration to v2
+	ctx = withV2(ctx)
+	require.NoError(t, keeper.NewMigrator(k).Migrate3to4(ctx))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10).WithEventManager(sdk.NewEventManager())
+	k.SetEVMAddress(ctx, valAddr, firstEVMAddress)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.SetEVMAddress(ctx, valAddr, secondEVMAddress)
+
+	// the reverse index follows the rotations
+	assert.True(t, k.IsEVMAddressUnique(ctx, initialEVMAddress))
+	assert.True(t, k.IsEVMAddressUnique(ctx, firstEVMAddress))
+	assert.False(t, k.IsEVMAddressUnique(ctx, secondEVMAddress))
+	got, exists := k.GetValidatorByEVMAddress(ctx, secondEVMAddress)
+	require.True(t, exists)
+	assert.Equal(t, valAddr, got)
+
+	// each rotation emits an event
+	rotations := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEVMAddressRotation {
+			rotations++
+		}
+	}
+	assert.Equal(t, 2, rotations)
+
+	history := k.GetEVMAddressHistory(ctx, valAddr)
+	assert.Equal(t, []types.EVMAddressRecord{
+		{Height: 0, EvmAddress: initialEVMAddress.Hex()},
+		{Height: initialHeight + 10, EvmAddress: firstEVMAddress.Hex()},
+		{Height: initialHeight + 20, EvmAddress: secondEVMAddress.Hex()},
+	}, history)
+
+	tests := []struct {
+		height   uint64
+		expected types.EVMAddressRecord
+	}{
+		{height: 0, expected: history[0]},
+		{height: initialHeight + 9, expected: history[0]},
+		{height: initialHeight + 10, expected: history[1]},
+		{height: initialHeight + 19, expected: history[1]},
+		{height: initialHeight + 20, expected: history[2]},
+		{height: math.MaxUint64, expected: history[2]},
+	}
+	for _, tt := range tests {
+		record, exists := k.GetEVMAddressAtHeight(ctx, valAddr, tt.height)
+		require.True(t, exists)
+		assert.Equal(t, tt.expected, record, tt.height)
+	}
+
+	// the histories of different validators are kept apart
+	otherHistory := k.GetEVMAddressHistory(ctx, testutil.ValAddrs[1])
+	require.Len(t, otherHistory, 1)
+	assert.Equal(t, testutil.EVMAddrs[1].Hex(), otherHistory[0].EvmAddress)
+
+	resp, err := k.EVMAddressAtHeight(ctx, &types.QueryEVMAddressAtHeightRequest{
+		ValidatorAddress: valAddr.String(),
+		Height:           initialHeight + 15,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, history[1], resp.Record)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the Blobstream store from consensus version 2 to 3. It
+// sets the AttestationExpiryTime and SignificantPowerDifferenceThreshold
+// params, which used to be hard-coded, to their default values while keeping
+// the DataCommitmentWindow param.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, m.keeper.GetParams(ctx))
+	return nil
+}
+
+// Migrate3to4 migrates the Blobstream store from consensus version 3 to 4. It
+// indexes the EVM addresses set before, see Keeper.IndexEVMAddresses.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.IndexEVMAddresses(ctx)
+	return nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	amino := codec.NewLegacyAmino()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, paramsTKey)
+
+	// set the params as they were in consensus version 2, i.e. only the data
+	// commitment window
+	window := uint64(101)
+	legacySpace := paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, types.DefaultParamspace).
+		WithKeyTable(paramtypes.NewKeyTable(
+			paramtypes.NewParamSetPair(types.ParamsStoreKeyDataCommitmentWindow, &window, func(interface{}) error { return nil }),
+		))
+	legacySpace.Set(ctx, types.ParamsStoreKeyDataCommitmentWindow, window)
+
+	paramSpace := paramtypes.NewSubspace(cdc, amino, paramsKey, paramsTKey, types.DefaultParamspace)
+	k := keeper.NewKeeper(cdc, sdk.NewKVStoreKey(types.StoreKey), paramSpace, nil)
+
+	// the params that are not in store have their default values
+	expected := types.DefaultParams()
+	expected.DataCommitmentWindow = window
+	assert.Equal(t, expected, k.GetParams(ctx))
+	assert.Equal(t, types.DefaultAttestationExpiryTime, k.GetAttestationExpiryTimeParam(ctx))
+	assert.Equal(t, types.DefaultSignificantPowerDifferenceThreshold, k.GetSignificantPowerDifferenceThresholdParam(ctx))
+	assert.False(t, legacySpace.Has(ctx, types.ParamsStoreKeyAttestationExpiryTime))
+	assert.False(t, legacySpace.Has(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold))
+
+	require.NoError(t, keeper.NewMigrator(*k).Migrate2to3(ctx))
+	assert.Equal(t, expected, k.GetParams(ctx))
+	assert.True(t, legacySpace.Has(ctx, types.ParamsStoreKeyAttestationExpiryTime))
+	assert.True(t, legacySpace.Has(ctx, types.ParamsStoreKeySignificantPowerDifferenceThreshold))
+
+	// the migrated params can be updated
+	expected.AttestationExpiryTime = time.Hour
+	expected.SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, expected)
+	assert.Equal(t, time.Hour, k.GetAttestationExpiryTimeParam(ctx))
+	assert.Equal(t, sdk.NewDecWithPrec(1, 1), k.GetSignificantPowerDifferenceThresholdParam(ctx))
+}
+
+func TestMigrate3to4(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	paramSpace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), sdk.NewKVStoreKey(paramtypes.StoreKey), sdk.NewTransientStoreKey(paramtypes.TStoreKey), types.DefaultParamspace)
+	k := keeper.NewKeeper(cdc, storeKey, paramSpace, nil)
+
+	// set the EVM addresses as they were in consensus version 3, i.e. without
+	// the reverse index nor the history
+	valAddrs := []sdk.ValAddress{sdk.ValAddress("validator1"), sdk.ValAddress("validator2")}
+	evmAddrs := []gethcommon.Address{gethcommon.BytesToAddress([]byte("evm1")), gethcommon.BytesToAddress([]byte("evm2"))}
+	v1Ctx := ctx.WithBlockHeader(tmproto.Header{Version: tmversion.Consensus{App: v1.Version}})
+	for i := range valAddrs {
+		ctx.KVStore(storeKey).Set(types.GetEVMKey(valAddrs[i]), evmAddrs[i].Bytes())
+		// the EVM addresses are scanned before the migration
+		assert.False(t, k.IsEVMAddressUnique(v1Ctx, evmAddrs[i]))
+	}
+	assert.True(t, k.IsEVMAddressUnique(v1Ctx, gethcommon.BytesToAddress([]byte("evm3"))))
+
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 100, Version: tmversion.Consensus{App: v2.Version}})
+	require.NoError(t, keeper.NewMigrator(*k).Migrate3to4(ctx))
+	for i := range valAddrs {
+		assert.False(t, k.IsEVMAddressUnique(ctx, evmAddrs[i]))
+		valAddr, exists := k.GetValidatorByEVMAddress(ctx, evmAddrs[i])
+		require.True(t, exists)
+		assert.Equal(t, valAddrs[i], valAddr)
+		assert.Equal(t, []types.EVMAddressRecord{{Height: 0, EvmAddress: evmAddrs[i].Hex()}}, k.GetEVMAddressHistory(ctx, valAddrs[i]))
+	}
+}
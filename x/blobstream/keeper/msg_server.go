@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -46,3 +48,94 @@ func (k Keeper) RegisterEVMAddress(goCtx context.Context, msg *types.MsgRegister
 
 	return &types.MsgRegisterEVMAddressResponse{}, nil
 }
+
+// SubmitAttestationSignature verifies the signature of a validator over the
+// digest of an attestation against the validator's registered EVM address. It
+// then stores the confirm along with the validator's power in the valset that
+// the attestation is checked against. A validator can only submit a single
+// signature per attestation. Signatures are only accepted from app version 2.
+func (k Keeper) SubmitAttestationSignature(goCtx context.Context, msg *types.MsgSubmitAttestationSignature) (*types.MsgSubmitAttestationSignatureResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !IsV2(ctx) {
+		return nil, errors.Wrapf(types.ErrUnsupportedAppVersion, "attestation signatures are only accepted from app version %d", v2.Version)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, exists := k.StakingKeeper.GetValidator(ctx, valAddr); !exists {
+		return nil, staking.ErrNoValidatorFound
+	}
+
+	evmAddr, exists := k.GetEVMAddress(ctx, valAddr)
+	if !exists {
+		return nil, errors.Wrapf(types.ErrEVMAddressNotFound, "validator %s", msg.ValidatorAddress)
+	}
+
+	_, exists, err = k.GetAttestationConfirm(ctx, msg.Nonce, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.Wrapf(types.ErrDuplicateAttestationSignature, "nonce %d validator %s", msg.Nonce, msg.ValidatorAddress)
+	}
+
+	at, err := k.GetAttestation(ctx, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := k.GetAttestationSignBytes(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := types.RecoverEVMAddress(digest, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != evmAddr {
+		return nil, errors.Wrapf(types.ErrInvalidAttestationSignature, "signer %s is not the registered EVM address %s", signer.Hex(), evmAddr.Hex())
+	}
+
+	vs, err := k.GetSigningValset(ctx, msg.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	power, isMember := uint64(0), false
+	for _, member := range vs.Members {
+		if gethcommon.HexToAddress(member.EvmAddress) == evmAddr {
+			power, isMember = member.Power, true
+			break
+		}
+	}
+	if !isMember {
+		return nil, errors.Wrapf(types.ErrValidatorNotInValset, "EVM address %s valset nonce %d", evmAddr.Hex(), vs.Nonce)
+	}
+
+	err = k.SetAttestationConfirm(ctx, types.AttestationConfirm{
+		Nonce:            msg.Nonce,
+		ValidatorAddress: msg.ValidatorAddress,
+		EvmAddress:       evmAddr.Hex(),
+		Signature:        msg.Signature,
+		Power:            power,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestationConfirm,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(msg.Nonce)),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprint(power)),
+		),
+	)
+
+	return &types.MsgSubmitAttestationSignatureResponse{}, nil
+}
@@ -1,13 +1,20 @@
 package keeper_test
 
 import (
+	"crypto/ecdsa"
 	"testing"
 
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/x/blobstream/keeper"
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 )
 
 func TestRegisterEVMAddress(t *testing.T) {
@@ -36,3 +43,143 @@ func TestRegisterEVMAddress(t *testing.T) {
 	addr, _ := k.GetEVMAddress(sdkCtx, val.GetOperator())
 	require.Equal(t, evmAddr, addr)
 }
+
+func TestSubmitAttestationSignature(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+
+	// attestation signatures are only accepted from v2
+	_, err := k.SubmitAttestationSignature(sdkCtx, types.NewMsgSubmitAttestationSignature(testutil.ValAddrs[0], 1, make([]byte, types.EVMSignatureLength)))
+	require.ErrorIs(t, err, types.ErrUnsupportedAppVersion)
+	sdkCtx = withV2(sdkCtx)
+	input.Context = sdkCtx
+
+	// register EVM addresses that we hold the keys of
+	keys := make([]*ecdsa.PrivateKey, len(testutil.ValAddrs))
+	for i, valAddr := range testutil.ValAddrs {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		testutil.RegisterEVMAddress(t, input, valAddr, crypto.PubkeyToAddress(key.PublicKey))
+	}
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	k.SetEarliestAvailableAttestationNonce(sdkCtx, 1)
+	dc := types.NewDataCommitment(2, 1, 11, sdkCtx.BlockTime())
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, dc))
+	for height := dc.BeginBlock; height < dc.EndBlock; height++ {
+		require.NoError(t, k.SetDataRoot(sdkCtx, height, tmrand.Bytes(types.DataRootSize)))
+	}
+
+	sign := func(nonce uint64, key *ecdsa.PrivateKey) []byte {
+		at, err := k.GetAttestation(sdkCtx, nonce)
+		require.NoError(t, err)
+		digest, err := k.GetAttestationSignBytes(sdkCtx, at)
+		require.NoError(t, err)
+		signature, err := crypto.Sign(accounts.TextHash(digest.Bytes()), key)
+		require.NoError(t, err)
+		return signature
+	}
+	submit := func(valAddr sdk.ValAddress, nonce uint64, signature []byte) error {
+		_, err := k.SubmitAttestationSignature(sdkCtx, types.NewMsgSubmitAttestationSignature(valAddr, nonce, signature))
+		return err
+	}
+
+	// the initial valset is checked against itself
+	require.NoError(t, submit(testutil.ValAddrs[0], 1, sign(1, keys[0])))
+	confirm, found, err := k.GetAttestationConfirm(sdkCtx, 1, testutil.ValAddrs[0])
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, crypto.PubkeyToAddress(keys[0].PublicKey).Hex(), confirm.EvmAddress)
+	assert.Equal(t, initialValset.Members[0].Power, confirm.Power)
+
+	// a validator can only sign an attestation once
+	err = submit(testutil.ValAddrs[0], 1, sign(1, keys[0]))
+	assert.ErrorIs(t, err, types.ErrDuplicateAttestationSignature)
+
+	// the signature must be from the registered EVM address
+	err = submit(testutil.ValAddrs[1], dc.Nonce, sign(dc.Nonce, keys[2]))
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+	// and over the digest of the attestation with the provided nonce
+	err = submit(testutil.ValAddrs[1], dc.Nonce, sign(1, keys[1]))
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+	// the attestation must exist
+	err = submit(testutil.ValAddrs[1], 3, sign(dc.Nonce, keys[1]))
+	assert.ErrorIs(t, err, types.ErrAttestationNotFound)
+
+	// the power accumulates until reaching the threshold of the valset
+	threshold := initialValset.TwoThirdsThreshold()
+	for i := 0; i < 4; i++ {
+		power, vs, err := k.GetAttestationConfirmPower(sdkCtx, dc.Nonce)
+		require.NoError(t, err)
+		assert.Equal(t, initialValset.Nonce, vs.Nonce)
+		assert.Less(t, power, threshold)
+
+		signature := sign(dc.Nonce, keys[i])
+		signature[crypto.RecoveryIDOffset] += 27
+		require.NoError(t, submit(testutil.ValAddrs[i], dc.Nonce, signature))
+	}
+	power, _, err := k.GetAttestationConfirmPower(sdkCtx, dc.Nonce)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, power, threshold)
+	confirms, err := k.GetAttestationConfirms(sdkCtx, dc.Nonce)
+	require.NoError(t, err)
+	assert.Len(t, confirms, 4)
+
+	// a validator that rotated its EVM address after the valset was created
+	// isn't a member of it
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	testutil.RegisterEVMAddress(t, input, testutil.ValAddrs[4], crypto.PubkeyToAddress(key.PublicKey))
+	err = submit(testutil.ValAddrs[4], dc.Nonce, sign(dc.Nonce, key))
+	assert.ErrorIs(t, err, types.ErrValidatorNotInValset)
+
+	k.DeleteAttestationConfirms(sdkCtx, dc.Nonce)
+	confirms, err = k.GetAttestationConfirms(sdkCtx, dc.Nonce)
+	require.NoError(t, err)
+	assert.Empty(t, confirms)
+}
+
+func TestQueryAttestationConfirmPower(t *testing.T) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	sdkCtx = withV2(sdkCtx)
+
+	initialValset, err := k.GetCurrentValset(sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, k.SetAttestationRequest(sdkCtx, &initialValset))
+	k.SetEarliestAvailableAttestationNonce(sdkCtx, 1)
+	require.NoError(t, k.SetAttestationConfirm(sdkCtx, types.AttestationConfirm{
+		Nonce:            1,
+		ValidatorAddress: testutil.ValAddrs[0].String(),
+		Power:            initialValset.TwoThirdsThreshold(),
+	}))
+
+	res, err := k.AttestationConfirmPower(sdkCtx, &types.QueryAttestationConfirmPowerRequest{Nonce: 1})
+	require.NoError(t, err)
+	assert.Equal(t, &types.QueryAttestationConfirmPowerResponse{
+		Power:            initialValset.TwoThirdsThreshold(),
+		Threshold:        initialValset.TwoThirdsThreshold(),
+		ThresholdReached: true,
+		ValsetNonce:      1,
+	}, res)
+
+	confirms, err := k.AttestationConfirms(sdkCtx, &types.QueryAttestationConfirmsRequest{Nonce: 1})
+	require.NoError(t, err)
+	assert.Len(t, confirms.Confirms, 1)
+
+	_, err = keeper.NewMsgServerImpl(k).SubmitAttestationSignature(sdkCtx, types.NewMsgSubmitAttestationSignature(testutil.ValAddrs[0], 1, make([]byte, types.EVMSignatureLength)))
+	assert.ErrorIs(t, err, types.ErrDuplicateAttestationSignature)
+
+	_, err = k.AttestationConfirms(sdkCtx, &types.QueryAttestationConfirmsRequest{Nonce: 2})
+	assert.ErrorIs(t, err, types.ErrAttestationNotFound)
+}
+
+// withV2 returns the context with its block header at app version 2.
+func withV2(ctx sdk.Context) sdk.Context {
+	header := ctx.BlockHeader()
+	header.Version.App = v2.Version
+	return ctx.WithBlockHeader(header)
+}
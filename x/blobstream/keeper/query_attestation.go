@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
+	"cosmossdk.io/errors"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,3 +54,127 @@ func (k Keeper) LatestAttestationNonce(
 		Nonce: k.GetLatestAttestationNonce(sdk.UnwrapSDKContext(ctx)),
 	}, nil
 }
+
+// Attestations queries the attestations with nonces in the requested range,
+// filtered by the requested type, in pages. As for the other paginated
+// queries, the total is only counted when the page is requested by offset,
+// i.e. without a pagination key.
+func (k Keeper) Attestations(
+	ctx context.Context,
+	request *types.QueryAttestationsRequest,
+) (*types.QueryAttestationsResponse, error) {
+	unwrappedCtx := sdk.UnwrapSDKContext(ctx)
+	if !k.CheckLatestAttestationNonce(unwrappedCtx) {
+		return nil, types.ErrLatestAttestationNonceStillNotInitialized
+	}
+	if !k.CheckEarliestAvailableAttestationNonce(unwrappedCtx) {
+		return nil, types.ErrEarliestAvailableNonceStillNotInitialized
+	}
+	if _, ok := types.AttestationType_name[int32(request.Type)]; !ok {
+		return nil, errors.Wrap(types.ErrUnknownAttestationTypeFilter, request.Type.String())
+	}
+	beginNonce := request.BeginNonce
+	if earliestNonce := k.GetEarliestAvailableAttestationNonce(unwrappedCtx); beginNonce < earliestNonce {
+		beginNonce = earliestNonce
+	}
+	endNonce := request.EndNonce
+	if latestNonce := k.GetLatestAttestationNonce(unwrappedCtx); endNonce == 0 || endNonce > latestNonce {
+		endNonce = latestNonce
+	}
+	if request.EndNonce != 0 && request.BeginNonce > request.EndNonce {
+		return nil, errors.Wrap(
+			types.ErrInvalidNonceRange,
+			fmt.Sprintf("begin nonce %d is higher than end nonce %d", request.BeginNonce, request.EndNonce),
+		)
+	}
+
+	store := nonceRangeStore{
+		KVStore: prefix.NewStore(unwrappedCtx.KVStore(k.storeKey), []byte(types.AttestationRequestKey)),
+		start:   types.UInt64Bytes(beginNonce),
+		end:     types.UInt64Bytes(endNonce + 1),
+	}
+	if beginNonce > endNonce || !store.contains(request.GetPagination().GetKey()) {
+		// the requested range was pruned, or the page starts outside of it
+		return &types.QueryAttestationsResponse{Pagination: &query.PageResponse{}}, nil
+	}
+
+	var attestations []*codectypes.Any
+	pageResponse, err := query.FilteredPaginate(store, request.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var at types.AttestationRequestI
+		if err := k.cdc.UnmarshalInterface(value, &at); err != nil {
+			return false, types.ErrUnmarshalllAttestation
+		}
+		if !request.Type.Matches(at) {
+			return false, nil
+		}
+		if accumulate {
+			val, err := codectypes.NewAnyWithValue(at)
+			if err != nil {
+				return false, err
+			}
+			attestations = append(attestations, val)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationsResponse{
+		Attestations: attestations,
+		Pagination:   pageResponse,
+	}, nil
+}
+
+// AttestationNonceRangeForTime queries the nonces of the earliest and latest
+// attestations created in the requested time range.
+func (k Keeper) AttestationNonceRangeForTime(
+	ctx context.Context,
+	request *types.QueryAttestationNonceRangeForTimeRequest,
+) (*types.QueryAttestationNonceRangeForTimeResponse, error) {
+	beginNonce, endNonce, err := k.GetAttestationNonceRangeForTime(sdk.UnwrapSDKContext(ctx), request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationNonceRangeForTimeResponse{
+		BeginNonce: beginNonce,
+		EndNonce:   endNonce,
+	}, nil
+}
+
+// nonceRangeStore restricts the iterators of a store keyed by nonce to the
+// nonces in [start, end), so that the pagination of a nonce range stops at its
+// bounds.
+type nonceRangeStore struct {
+	storetypes.KVStore
+	start, end []byte
+}
+
+func (s nonceRangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s nonceRangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// contains returns true if the key is unset or in the nonce range.
+func (s nonceRangeStore) contains(key []byte) bool {
+	return key == nil || (bytes.Compare(key, s.start) >= 0 && bytes.Compare(key, s.end) < 0)
+}
+
+// clamp returns the intersection of [start, end) with the nonce range, which
+// is empty if the returned start isn't lower than the returned end.
+func (s nonceRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		end = s.end
+	}
+	if bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
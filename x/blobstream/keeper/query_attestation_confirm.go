@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AttestationConfirms queries the confirms submitted for the attestation with
+// the provided nonce.
+func (k Keeper) AttestationConfirms(
+	c context.Context,
+	request *types.QueryAttestationConfirmsRequest,
+) (*types.QueryAttestationConfirmsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, err := k.GetAttestation(ctx, request.Nonce); err != nil {
+		return nil, err
+	}
+	confirms, err := k.GetAttestationConfirms(ctx, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationConfirmsResponse{Confirms: confirms}, nil
+}
+
+// AttestationConfirmPower queries the power accumulated by the confirms of
+// the attestation with the provided nonce.
+func (k Keeper) AttestationConfirmPower(
+	c context.Context,
+	request *types.QueryAttestationConfirmPowerRequest,
+) (*types.QueryAttestationConfirmPowerResponse, error) {
+	power, vs, err := k.GetAttestationConfirmPower(sdk.UnwrapSDKContext(c), request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	threshold := vs.TwoThirdsThreshold()
+	return &types.QueryAttestationConfirmPowerResponse{
+		Power:            power,
+		Threshold:        threshold,
+		ThresholdReached: power >= threshold,
+		ValsetNonce:      vs.Nonce,
+	}, nil
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/celestiaorg/celestia-app/test/util"
+	"github.com/celestiaorg/celestia-app/x/blobstream/keeper"
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupAttestations stores 11 attestations created one hour apart, starting at
+// the returned time. The attestations with nonces 1 and 6 are valsets and the
+// others are data commitments.
+func setupAttestations(t *testing.T) (*keeper.Keeper, sdk.Context, time.Time) {
+	input, sdkCtx := testutil.SetupFiveValChain(t)
+	k := input.BlobstreamKeeper
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for nonce := uint64(1); nonce <= 11; nonce++ {
+		blockTime := startTime.Add(time.Duration(nonce-1) * time.Hour)
+		var at types.AttestationRequestI = types.NewDataCommitment(nonce, nonce*10, (nonce+1)*10, blockTime)
+		if nonce == 1 || nonce == 6 {
+			at = &types.Valset{Nonce: nonce, Height: nonce * 10, Time: blockTime}
+		}
+		require.NoError(t, k.SetAttestationRequest(sdkCtx, at))
+	}
+	return &k, sdkCtx, startTime
+}
+
+func TestAttestationsQuery(t *testing.T) {
+	k, sdkCtx, _ := setupAttestations(t)
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	nonces := func(t *testing.T, resp *types.QueryAttestationsResponse) []uint64 {
+		var nonces []uint64
+		for _, any := range resp.Attestations {
+			at, ok := any.GetCachedValue().(types.AttestationRequestI)
+			require.True(t, ok)
+			nonces = append(nonces, at.GetNonce())
+		}
+		return nonces
+	}
+
+	tests := []struct {
+		name     string
+		req      *types.QueryAttestationsRequest
+		expected []uint64
+		nextKey  bool
+		total    uint64
+	}{
+		{
+			name:     "all attestations",
+			req:      &types.QueryAttestationsRequest{},
+			expected: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			total:    11,
+		},
+		{
+			name:     "nonce range",
+			req:      &types.QueryAttestationsRequest{BeginNonce: 3, EndNonce: 5},
+			expected: []uint64{3, 4, 5},
+			total:    3,
+		},
+		{
+			name:     "end nonce higher than latest nonce",
+			req:      &types.QueryAttestationsRequest{BeginNonce: 10, EndNonce: 20},
+			expected: []uint64{10, 11},
+			total:    2,
+		},
+		{
+			name:     "valsets",
+			req:      &types.QueryAttestationsRequest{Type: types.AttestationType_ATTESTATION_TYPE_VALSET},
+			expected: []uint64{1, 6},
+			total:    2,
+		},
+		{
+			name: "data commitments in nonce range",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 5,
+				EndNonce:   7,
+				Type:       types.AttestationType_ATTESTATION_TYPE_DATA_COMMITMENT,
+			},
+			expected: []uint64{5, 7},
+			total:    2,
+		},
+		{
+			name: "first page",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Limit: 3},
+			},
+			expected: []uint64{2, 3, 4},
+			nextKey:  true,
+		},
+		{
+			name: "first page with total",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+			},
+			expected: []uint64{2, 3, 4},
+			nextKey:  true,
+			total:    7,
+		},
+		{
+			name: "last page",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Key: types.UInt64Bytes(8), Limit: 3},
+			},
+			expected: []uint64{8},
+		},
+		{
+			name: "reverse page",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Limit: 3, Reverse: true},
+			},
+			expected: []uint64{8, 7, 6},
+			nextKey:  true,
+		},
+		{
+			name: "page with offset",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Offset: 2, Limit: 3},
+			},
+			expected: []uint64{4, 5, 6},
+			nextKey:  true,
+		},
+		{
+			name: "page key after the range",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 2,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Key: types.UInt64Bytes(10), Limit: 3},
+			},
+		},
+		{
+			name: "reverse page key before the range",
+			req: &types.QueryAttestationsRequest{
+				BeginNonce: 4,
+				EndNonce:   8,
+				Pagination: &query.PageRequest{Key: types.UInt64Bytes(2), Limit: 3, Reverse: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := k.Attestations(ctx, tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, nonces(t, resp))
+			assert.Equal(t, tt.nextKey, resp.Pagination.NextKey != nil)
+			assert.Equal(t, tt.total, resp.Pagination.Total)
+		})
+	}
+
+	t.Run("iterate over pages", func(t *testing.T) {
+		var got []uint64
+		req := &types.QueryAttestationsRequest{
+			Type:       types.AttestationType_ATTESTATION_TYPE_DATA_COMMITMENT,
+			Pagination: &query.PageRequest{Limit: 2},
+		}
+		for {
+			resp, err := k.Attestations(ctx, req)
+			require.NoError(t, err)
+			got = append(got, nonces(t, resp)...)
+			if resp.Pagination.NextKey == nil {
+				break
+			}
+			req.Pagination.Key = resp.Pagination.NextKey
+		}
+		assert.Equal(t, []uint64{2, 3, 4, 5, 7, 8, 9, 10, 11}, got)
+	})
+
+	t.Run("pruned attestations", func(t *testing.T) {
+		k.SetEarliestAvailableAttestationNonce(sdkCtx, 9)
+		defer k.SetEarliestAvailableAttestationNonce(sdkCtx, 1)
+		resp, err := k.Attestations(ctx, &types.QueryAttestationsRequest{BeginNonce: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []uint64{9, 10, 11}, nonces(t, resp))
+		resp, err = k.Attestations(ctx, &types.QueryAttestationsRequest{BeginNonce: 1, EndNonce: 5})
+		require.NoError(t, err)
+		assert.Empty(t, resp.Attestations)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := k.Attestations(ctx, &types.QueryAttestationsRequest{BeginNonce: 5, EndNonce: 4})
+		assert.ErrorIs(t, err, types.ErrInvalidNonceRange)
+		_, err = k.Attestations(ctx, &types.QueryAttestationsRequest{Type: 3})
+		assert.ErrorIs(t, err, types.ErrUnknownAttestationTypeFilter)
+	})
+}
+
+func TestGetAttestationNonceRangeForTime(t *testing.T) {
+	k, sdkCtx, startTime := setupAttestations(t)
+	hour := func(n int) time.Time {
+		return startTime.Add(time.Duration(n) * time.Hour)
+	}
+
+	tests := []struct {
+		name          string
+		start, end    time.Time
+		expectedBegin uint64
+		expectedEnd   uint64
+		expectedErr   error
+	}{
+		{"all attestations", hour(-1), hour(20), 1, 11, nil},
+		{"exact bounds", hour(2), hour(5), 3, 5, nil},
+		{"between attestations", hour(2).Add(time.Minute), hour(5).Add(time.Minute), 4, 6, nil},
+		{"single attestation", hour(10), hour(11), 11, 11, nil},
+		{"before the first attestation", hour(-2), hour(0), 0, 0, types.ErrAttestationNotFound},
+		{"after the last attestation", hour(11), hour(12), 0, 0, types.ErrAttestationNotFound},
+		{"no attestation in range", hour(2).Add(time.Minute), hour(3), 0, 0, types.ErrAttestationNotFound},
+		{"empty range", hour(3), hour(3), 0, 0, types.ErrInvalidTimeRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			begin, end, err := k.GetAttestationNonceRangeForTime(sdkCtx, tt.start, tt.end)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBegin, begin)
+			assert.Equal(t, tt.expectedEnd, end)
+		})
+	}
+
+	// pruned attestations are not searched
+	k.SetEarliestAvailableAttestationNonce(sdkCtx, 5)
+	begin, end, err := k.GetAttestationNonceRangeForTime(sdkCtx, hour(0), hour(6))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), begin)
+	assert.Equal(t, uint64(6), end)
+}
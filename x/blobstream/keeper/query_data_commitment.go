@@ -30,3 +30,38 @@ func (k Keeper) LatestDataCommitment(
 		DataCommitment: &resp,
 	}, nil
 }
+
+// DataRootTupleRoot queries the data root tuple root of the data commitment
+// with the provided nonce.
+func (k Keeper) DataRootTupleRoot(
+	c context.Context,
+	request *types.QueryDataRootTupleRootRequest,
+) (*types.QueryDataRootTupleRootResponse, error) {
+	dc, root, err := k.GetDataRootTupleRoot(sdk.UnwrapSDKContext(c), request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDataRootTupleRootResponse{
+		DataCommitment:    &dc,
+		DataRootTupleRoot: root,
+	}, nil
+}
+
+// DataRootTupleInclusionProof queries a Merkle proof of the inclusion of the
+// data root tuple of the provided height in the data root tuple root of the
+// data commitment with the provided nonce.
+func (k Keeper) DataRootTupleInclusionProof(
+	c context.Context,
+	request *types.QueryDataRootTupleInclusionProofRequest,
+) (*types.QueryDataRootTupleInclusionProofResponse, error) {
+	dc, root, dataRoot, proof, err := k.GetDataRootTupleInclusionProof(sdk.UnwrapSDKContext(c), request.Nonce, request.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDataRootTupleInclusionProofResponse{
+		DataCommitment:    &dc,
+		DataRootTupleRoot: root,
+		DataRoot:          dataRoot,
+		Proof:             *proof.ToProto(),
+	}, nil
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
+
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -50,3 +52,29 @@ func (k Keeper) EVMAddress(goCtx context.Context, req *types.QueryEVMAddressRequ
 		EvmAddress: evmAddr.Hex(),
 	}, nil
 }
+
+// EVMAddressAtHeight tries to find the EVM address that was associated with a
+// given validator address at the provided height.
+func (k Keeper) EVMAddressAtHeight(goCtx context.Context, req *types.QueryEVMAddressAtHeightRequest) (*types.QueryEVMAddressAtHeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	record, exists := k.GetEVMAddressAtHeight(ctx, valAddr, req.Height)
+	if !exists {
+		return nil, errors.Wrapf(types.ErrEVMAddressNotFound, "validator %s height %d", req.ValidatorAddress, req.Height)
+	}
+	return &types.QueryEVMAddressAtHeightResponse{Record: record}, nil
+}
+
+// EVMAddressHistory returns the history of the EVM addresses associated with a
+// given validator address.
+func (k Keeper) EVMAddressHistory(goCtx context.Context, req *types.QueryEVMAddressHistoryRequest) (*types.QueryEVMAddressHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryEVMAddressHistoryResponse{Records: k.GetEVMAddressHistory(ctx, valAddr)}, nil
+}
@@ -16,3 +16,20 @@ type AttestationRequestI interface {
 	GetNonce() uint64
 	BlockTime() time.Time
 }
+
+// Matches returns true if the attestation is of type t. All the attestations
+// match ATTESTATION_TYPE_UNSPECIFIED.
+func (t AttestationType) Matches(at AttestationRequestI) bool {
+	switch t {
+	case AttestationType_ATTESTATION_TYPE_UNSPECIFIED:
+		return true
+	case AttestationType_ATTESTATION_TYPE_VALSET:
+		_, ok := at.(*Valset)
+		return ok
+	case AttestationType_ATTESTATION_TYPE_DATA_COMMITMENT:
+		_, ok := at.(*DataCommitment)
+		return ok
+	default:
+		return false
+	}
+}
@@ -7,15 +7,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-const URLMsgRegisterEVMAddress = "/celestia.blob.v1.MsgRegisterEVMAddress"
+const (
+	URLMsgRegisterEVMAddress         = "/celestia.blob.v1.MsgRegisterEVMAddress"
+	URLMsgSubmitAttestationSignature = "/celestia.qgb.v1.MsgSubmitAttestationSignature"
+)
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterEVMAddress{}, URLMsgRegisterEVMAddress, nil)
+	cdc.RegisterConcrete(&MsgSubmitAttestationSignature{}, URLMsgSubmitAttestationSignature, nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterEVMAddress{},
+		&MsgSubmitAttestationSignature{},
 	)
 
 	registry.RegisterInterface(
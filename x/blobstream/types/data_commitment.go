@@ -1,9 +1,20 @@
 package types
 
-import "time"
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 var _ AttestationRequestI = &DataCommitment{}
 
+// DataRootSize is the size of a block data root in bytes.
+const DataRootSize = 32
+
 // NewDataCommitment creates a new DataCommitment.
 func NewDataCommitment(
 	nonce uint64,
@@ -22,3 +33,37 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// EncodeDataRootTuple encodes the (height, data root) tuple of a block the same
+// way as the Blobstream contract: the height left padded to 32 bytes followed
+// by the data root.
+func EncodeDataRootTuple(height uint64, dataRoot []byte) ([]byte, error) {
+	if len(dataRoot) != DataRootSize {
+		return nil, fmt.Errorf("data root of height %d has size %d, expected %d", height, len(dataRoot), DataRootSize)
+	}
+	encoded := make([]byte, 32+DataRootSize)
+	binary.BigEndian.PutUint64(encoded[24:32], height)
+	copy(encoded[32:], dataRoot)
+	return encoded, nil
+}
+
+// DataCommitmentSignBytes produces the bytes that celestia validators are
+// required to sign over when committing to the data root tuple root of the
+// data commitment with the provided nonce.
+func DataCommitmentSignBytes(nonce uint64, dataRootTupleRoot []byte) (ethcmn.Hash, error) {
+	if len(dataRootTupleRoot) != ethcmn.HashLength {
+		return ethcmn.Hash{}, fmt.Errorf("data root tuple root has size %d, expected %d", len(dataRootTupleRoot), ethcmn.HashLength)
+	}
+	bytes, err := InternalBlobstreamABI.Pack(
+		"domainSeparateDataRootTupleRoot",
+		DcDomainSeparator,
+		new(big.Int).SetUint64(nonce),
+		ethcmn.BytesToHash(dataRootTupleRoot),
+	)
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+	// the first 4 bytes are the function selector which is not part of the
+	// digest.
+	return crypto.Keccak256Hash(bytes[4:]), nil
+}
@@ -3,4 +3,12 @@ package types
 const (
 	EventTypeAttestationRequest = "AttestationRequest"
 	AttributeKeyNonce           = "nonce"
+
+	EventTypeAttestationConfirm = "AttestationConfirm"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyPower           = "power"
+
+	EventTypeEVMAddressRotation    = "EVMAddressRotation"
+	AttributeKeyPreviousEVMAddress = "previous_evm_address"
+	AttributeKeyEVMAddress         = "evm_address"
 )
@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,18 +18,45 @@ const (
 	// MinimumDataCommitmentWindow is a constant that defines the minimum
 	// allowable window for the Blobstream data commitments.
 	MinimumDataCommitmentWindow = 100
+
+	// DefaultAttestationExpiryTime is the default expiration time of an
+	// attestation. It matches the consensus unbonding time.
+	DefaultAttestationExpiryTime = 3 * 7 * 24 * time.Hour // 3 weeks
 )
 
-// ParamsStoreKeyDataCommitmentWindow is the key used for the
-// DataCommitmentWindow param.
-var ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+// DefaultSignificantPowerDifferenceThreshold is the default threshold of
+// change in the validator set power that would trigger the creation of a new
+// valset request.
+var DefaultSignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
+
+var (
+	// ParamsStoreKeyDataCommitmentWindow is the key used for the
+	// DataCommitmentWindow param.
+	ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+
+	// ParamsStoreKeyAttestationExpiryTime is the key used for the
+	// AttestationExpiryTime param.
+	ParamsStoreKeyAttestationExpiryTime = []byte("AttestationExpiryTime")
+
+	// ParamsStoreKeySignificantPowerDifferenceThreshold is the key used for
+	// the SignificantPowerDifferenceThreshold param.
+	ParamsStoreKeySignificantPowerDifferenceThreshold = []byte("SignificantPowerDifferenceThreshold")
+)
+
+// DefaultParams returns the default Blobstream params.
+func DefaultParams() Params {
+	return Params{
+		DataCommitmentWindow:                400,
+		AttestationExpiryTime:               DefaultAttestationExpiryTime,
+		SignificantPowerDifferenceThreshold: DefaultSignificantPowerDifferenceThreshold,
+	}
+}
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
-		Params: &Params{
-			DataCommitmentWindow: 400,
-		},
+		Params: &params,
 	}
 }
 
@@ -51,6 +80,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamsStoreKeyDataCommitmentWindow, &p.DataCommitmentWindow, validateDataCommitmentWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAttestationExpiryTime, &p.AttestationExpiryTime, validateAttestationExpiryTime),
+		paramtypes.NewParamSetPair(
+			ParamsStoreKeySignificantPowerDifferenceThreshold,
+			&p.SignificantPowerDifferenceThreshold,
+			validateSignificantPowerDifferenceThreshold,
+		),
 	}
 }
 
@@ -75,10 +110,44 @@ func validateDataCommitmentWindow(i interface{}) error {
 	return nil
 }
 
+func validateAttestationExpiryTime(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return errors.Wrap(ErrInvalidAttestationExpiryTime, fmt.Sprintf(
+			"attestation expiry time %v must be positive",
+			val,
+		))
+	}
+	return nil
+}
+
+func validateSignificantPowerDifferenceThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || !val.IsPositive() || val.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalidSignificantPowerDifferenceThreshold, fmt.Sprintf(
+			"significant power difference threshold %v must be in (0, 1]",
+			val,
+		))
+	}
+	return nil
+}
+
 // ValidateBasic checks that the parameters have valid values.
 func (p Params) ValidateBasic() error {
 	if err := validateDataCommitmentWindow(p.DataCommitmentWindow); err != nil {
 		return errors.Wrap(err, "data commitment window")
 	}
+	if err := validateAttestationExpiryTime(p.AttestationExpiryTime); err != nil {
+		return errors.Wrap(err, "attestation expiry time")
+	}
+	if err := validateSignificantPowerDifferenceThreshold(p.SignificantPowerDifferenceThreshold); err != nil {
+		return errors.Wrap(err, "significant power difference threshold")
+	}
 	return nil
 }
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/pkg/appconsts"
 
 	"github.com/celestiaorg/celestia-app/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,35 +28,69 @@ func TestGenesisStateValidate(t *testing.T) {
 			expErr: true,
 		},
 		"invalid params: short block time": {
-			src: &types.GenesisState{
-				Params: &types.Params{
-					DataCommitmentWindow: types.MinimumDataCommitmentWindow - 1,
-				},
-			},
+			src: genesisWithParams(func(p *types.Params) {
+				p.DataCommitmentWindow = types.MinimumDataCommitmentWindow - 1
+			}),
 			expErr: true,
 		},
 		"invalid params: long block time": {
-			src: &types.GenesisState{
-				Params: &types.Params{
-					DataCommitmentWindow: uint64(appconsts.DataCommitmentBlocksLimit + 1),
-				},
-			},
+			src: genesisWithParams(func(p *types.Params) {
+				p.DataCommitmentWindow = uint64(appconsts.DataCommitmentBlocksLimit + 1)
+			}),
 			expErr: true,
 		},
 		"valid params: data commitments blocks limit": {
-			src: &types.GenesisState{
-				Params: &types.Params{
-					DataCommitmentWindow: uint64(appconsts.DataCommitmentBlocksLimit),
-				},
-			},
+			src: genesisWithParams(func(p *types.Params) {
+				p.DataCommitmentWindow = uint64(appconsts.DataCommitmentBlocksLimit)
+			}),
 			expErr: false,
 		},
 		"valid params: minimum data commitment window": {
-			src: &types.GenesisState{
-				Params: &types.Params{
-					DataCommitmentWindow: types.MinimumDataCommitmentWindow,
-				},
-			},
+			src: genesisWithParams(func(p *types.Params) {
+				p.DataCommitmentWindow = types.MinimumDataCommitmentWindow
+			}),
+			expErr: false,
+		},
+		"invalid params: zero attestation expiry time": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.AttestationExpiryTime = 0
+			}),
+			expErr: true,
+		},
+		"invalid params: negative attestation expiry time": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.AttestationExpiryTime = -time.Hour
+			}),
+			expErr: true,
+		},
+		"valid params: one day attestation expiry time": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.AttestationExpiryTime = 24 * time.Hour
+			}),
+			expErr: false,
+		},
+		"invalid params: nil significant power difference threshold": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.SignificantPowerDifferenceThreshold = sdk.Dec{}
+			}),
+			expErr: true,
+		},
+		"invalid params: zero significant power difference threshold": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.SignificantPowerDifferenceThreshold = sdk.ZeroDec()
+			}),
+			expErr: true,
+		},
+		"invalid params: significant power difference threshold higher than one": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.SignificantPowerDifferenceThreshold = sdk.NewDecWithPrec(101, 2)
+			}),
+			expErr: true,
+		},
+		"valid params: significant power difference threshold of one": {
+			src: genesisWithParams(func(p *types.Params) {
+				p.SignificantPowerDifferenceThreshold = sdk.OneDec()
+			}),
 			expErr: false,
 		},
 	}
@@ -69,3 +105,11 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+// genesisWithParams returns a genesis state with the default params modified
+// by modify.
+func genesisWithParams(modify func(*types.Params)) *types.GenesisState {
+	params := types.DefaultParams()
+	modify(&params)
+	return &types.GenesisState{Params: &params}
+}
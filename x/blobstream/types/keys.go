@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -40,6 +42,24 @@ const (
 
 	// EVMAddress indexes evm addresses by validator address
 	EVMAddress = "EVMAddress"
+
+	// DataRootKey indexes block data roots by height
+	DataRootKey = "DataRootKey"
+
+	// DataRootsStartHeight indexes the height of the first block whose data
+	// root was saved
+	DataRootsStartHeight = "DataRootsStartHeight"
+
+	// ValidatorByEVMAddressKey indexes validator addresses by evm address
+	ValidatorByEVMAddressKey = "ValidatorByEVMAddress"
+
+	// EVMAddressHistoryKey indexes the evm addresses of a validator by the
+	// height at which they were set
+	EVMAddressHistoryKey = "HistoricalEVMAddress"
+
+	// AttestationConfirmKey indexes attestation confirms by nonce and
+	// validator address
+	AttestationConfirmKey = "AttestationConfirmKey"
 )
 
 // GetAttestationKey returns the following key format
@@ -49,6 +69,28 @@ func GetAttestationKey(nonce uint64) string {
 	return AttestationRequestKey + string(UInt64Bytes(nonce))
 }
 
+// GetDataRootKey returns the following key format
+// prefix    height
+// [0x0][0 0 0 0 0 0 0 1]
+func GetDataRootKey(height uint64) string {
+	return DataRootKey + string(UInt64Bytes(height))
+}
+
+// GetAttestationConfirmsKey returns the prefix of the confirms of the
+// attestation with the provided nonce
+// prefix    nonce
+// [0x0][0 0 0 0 0 0 0 1]
+func GetAttestationConfirmsKey(nonce uint64) string {
+	return AttestationConfirmKey + string(UInt64Bytes(nonce))
+}
+
+// GetAttestationConfirmKey returns the following key format
+// prefix    nonce                validator address
+// [0x0][0 0 0 0 0 0 0 1][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetAttestationConfirmKey(nonce uint64, valAddress sdk.ValAddress) string {
+	return GetAttestationConfirmsKey(nonce) + string(valAddress.Bytes())
+}
+
 func ConvertByteArrToString(value []byte) string {
 	var ret strings.Builder
 	for i := 0; i < len(value); i++ {
@@ -60,3 +102,23 @@ func ConvertByteArrToString(value []byte) string {
 func GetEVMKey(valAddress sdk.ValAddress) []byte {
 	return append([]byte(EVMAddress), valAddress...)
 }
+
+// GetValidatorByEVMAddressKey returns the following key format
+// prefix              evm address
+// [0x0][0xc783df8a850f42e7f7e57013759c285caa701eb6]
+func GetValidatorByEVMAddressKey(evmAddress common.Address) []byte {
+	return append([]byte(ValidatorByEVMAddressKey), evmAddress.Bytes()...)
+}
+
+// GetEVMAddressHistoryPrefix returns the prefix of the evm address history of
+// the provided validator. The validator address is length prefixed.
+func GetEVMAddressHistoryPrefix(valAddress sdk.ValAddress) []byte {
+	return append([]byte(EVMAddressHistoryKey), address.MustLengthPrefix(valAddress)...)
+}
+
+// GetEVMAddressHistoryKey returns the following key format
+// prefix    len  validator address                            height
+// [0x0][0x14][0xc783df8a850f42e7f7e57013759c285caa701eb6][0 0 0 0 0 0 0 1]
+func GetEVMAddressHistoryKey(valAddress sdk.ValAddress, height uint64) []byte {
+	return append(GetEVMAddressHistoryPrefix(valAddress), UInt64Bytes(height)...)
+}
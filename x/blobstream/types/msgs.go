@@ -1,11 +1,15 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
-var _ sdk.Msg = &MsgRegisterEVMAddress{}
+var (
+	_ sdk.Msg = &MsgRegisterEVMAddress{}
+	_ sdk.Msg = &MsgSubmitAttestationSignature{}
+)
 
 func NewMsgRegisterEVMAddress(valAddress sdk.ValAddress, evmAddress common.Address) *MsgRegisterEVMAddress {
 	msg := &MsgRegisterEVMAddress{
@@ -37,3 +41,40 @@ func (msg MsgRegisterEVMAddress) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
 }
+
+func NewMsgSubmitAttestationSignature(valAddress sdk.ValAddress, nonce uint64, signature []byte) *MsgSubmitAttestationSignature {
+	msg := &MsgSubmitAttestationSignature{
+		ValidatorAddress: valAddress.String(),
+		Nonce:            nonce,
+		Signature:        signature,
+	}
+	return msg
+}
+
+// ValidateBasic verifies that the val address is of a valid type, that the
+// nonce is set and that the signature has the expected length
+func (msg MsgSubmitAttestationSignature) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if msg.Nonce == 0 {
+		return errors.Wrap(ErrInvalidAttestationSignature, "nonce cannot be zero")
+	}
+
+	if len(msg.Signature) != EVMSignatureLength {
+		return errors.Wrapf(ErrInvalidAttestationSignature, "signature has size %d, expected %d", len(msg.Signature), EVMSignatureLength)
+	}
+
+	return nil
+}
+
+// GetSigner fulfills the sdk.Msg interface. The signer must be the validator address
+func (msg MsgSubmitAttestationSignature) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
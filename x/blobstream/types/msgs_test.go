@@ -20,3 +20,18 @@ func TestValidateBasic(t *testing.T) {
 	msg = &MsgRegisterEVMAddress{"invalid validator address", evmAddr.Hex()}
 	require.Error(t, msg.ValidateBasic())
 }
+
+func TestSubmitAttestationSignatureValidateBasic(t *testing.T) {
+	valAddr, err := sdk.ValAddressFromBech32("cosmosvaloper1xcy3els9ua75kdm783c3qu0rfa2eples6eavqq")
+	require.NoError(t, err)
+	signature := make([]byte, EVMSignatureLength)
+
+	msg := NewMsgSubmitAttestationSignature(valAddr, 1, signature)
+	require.NoError(t, msg.ValidateBasic())
+	msg = &MsgSubmitAttestationSignature{valAddr.String(), 0, signature}
+	require.Error(t, msg.ValidateBasic())
+	msg = &MsgSubmitAttestationSignature{valAddr.String(), 1, signature[1:]}
+	require.Error(t, msg.ValidateBasic())
+	msg = &MsgSubmitAttestationSignature{"invalid validator address", 1, signature}
+	require.Error(t, msg.ValidateBasic())
+}
@@ -8,22 +8,28 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +37,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AttestationType is the type of an attestation.
+type AttestationType int32
+
+const (
+	// ATTESTATION_TYPE_UNSPECIFIED matches all the attestation types.
+	AttestationType_ATTESTATION_TYPE_UNSPECIFIED AttestationType = 0
+	// ATTESTATION_TYPE_VALSET matches valsets.
+	AttestationType_ATTESTATION_TYPE_VALSET AttestationType = 1
+	// ATTESTATION_TYPE_DATA_COMMITMENT matches data commitments.
+	AttestationType_ATTESTATION_TYPE_DATA_COMMITMENT AttestationType = 2
+)
+
+var AttestationType_name = map[int32]string{
+	0: "ATTESTATION_TYPE_UNSPECIFIED",
+	1: "ATTESTATION_TYPE_VALSET",
+	2: "ATTESTATION_TYPE_DATA_COMMITMENT",
+}
+
+var AttestationType_value = map[string]int32{
+	"ATTESTATION_TYPE_UNSPECIFIED":     0,
+	"ATTESTATION_TYPE_VALSET":          1,
+	"ATTESTATION_TYPE_DATA_COMMITMENT": 2,
+}
+
+func (x AttestationType) String() string {
+	return proto.EnumName(AttestationType_name, int32(x))
+}
+
+func (AttestationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{0}
+}
+
 // QueryParamsRequest
 type QueryParamsRequest struct {
 }
@@ -208,22 +246,35 @@ func (m *QueryAttestationRequestByNonceResponse) GetAttestation() *types.Any {
 	return nil
 }
 
-// QueryLatestAttestationNonceRequest latest attestation nonce request
-type QueryLatestAttestationNonceRequest struct {
-}
-
-func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestAttestationNonceRequest{} }
-func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
-func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
+// QueryAttestationsRequest
+type QueryAttestationsRequest struct {
+	// begin_nonce is the nonce of the first attestation to return. If it is
+	// zero or lower than the earliest available attestation nonce, the
+	// attestations start at the earliest available attestation nonce.
+	BeginNonce uint64 `protobuf:"varint,1,opt,name=begin_nonce,json=beginNonce,proto3" json:"begin_nonce,omitempty"`
+	// end_nonce is the nonce of the last attestation to return. If it is zero,
+	// the attestations end at the latest attestation nonce.
+	EndNonce uint64 `protobuf:"varint,2,opt,name=end_nonce,json=endNonce,proto3" json:"end_nonce,omitempty"`
+	// type filters the attestations by type.
+	Type AttestationType `protobuf:"varint,3,opt,name=type,proto3,enum=celestia.qgb.v1.AttestationType" json:"type,omitempty"`
+	// pagination pages the attestations of the nonce range. The total is only
+	// counted when the page is requested without a key, and a key outside of
+	// the nonce range returns an empty page.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsRequest) Reset()         { *m = QueryAttestationsRequest{} }
+func (m *QueryAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsRequest) ProtoMessage()    {}
+func (*QueryAttestationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{4}
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestAttestationNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -233,35 +284,65 @@ func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestAttestationNonceRequest.Merge(m, src)
+func (m *QueryAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsRequest.Merge(m, src)
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_Size() int {
+func (m *QueryAttestationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestAttestationNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestAttestationNonceRequest.DiscardUnknown(m)
+func (m *QueryAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestAttestationNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsRequest proto.InternalMessageInfo
 
-// QueryLatestAttestationNonceResponse latest attestation nonce response
-type QueryLatestAttestationNonceResponse struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QueryAttestationsRequest) GetBeginNonce() uint64 {
+	if m != nil {
+		return m.BeginNonce
+	}
+	return 0
 }
 
-func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatestAttestationNonceResponse{} }
-func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
-func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationsRequest) GetEndNonce() uint64 {
+	if m != nil {
+		return m.EndNonce
+	}
+	return 0
+}
+
+func (m *QueryAttestationsRequest) GetType() AttestationType {
+	if m != nil {
+		return m.Type
+	}
+	return AttestationType_ATTESTATION_TYPE_UNSPECIFIED
+}
+
+func (m *QueryAttestationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationsResponse
+type QueryAttestationsResponse struct {
+	// attestations are either Data Commitments or Valsets ordered by nonce.
+	Attestations []*types.Any        `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttestationsResponse) Reset()         { *m = QueryAttestationsResponse{} }
+func (m *QueryAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsResponse) ProtoMessage()    {}
+func (*QueryAttestationsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{5}
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestAttestationNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,41 +352,54 @@ func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestAttestationNonceResponse.Merge(m, src)
+func (m *QueryAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsResponse.Merge(m, src)
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_Size() int {
+func (m *QueryAttestationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestAttestationNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestAttestationNonceResponse.DiscardUnknown(m)
+func (m *QueryAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestAttestationNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationsResponse proto.InternalMessageInfo
 
-func (m *QueryLatestAttestationNonceResponse) GetNonce() uint64 {
+func (m *QueryAttestationsResponse) GetAttestations() []*types.Any {
 	if m != nil {
-		return m.Nonce
+		return m.Attestations
 	}
-	return 0
+	return nil
 }
 
-// QueryEarliestAttestationNonceRequest earliest attestation nonce request
-type QueryEarliestAttestationNonceRequest struct {
+func (m *QueryAttestationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarliestAttestationNonceRequest{} }
-func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
-func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
+// QueryAttestationNonceRangeForTimeRequest
+type QueryAttestationNonceRangeForTimeRequest struct {
+	// start_time is the inclusive start of the time range.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the exclusive end of the time range.
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *QueryAttestationNonceRangeForTimeRequest) Reset() {
+	*m = QueryAttestationNonceRangeForTimeRequest{}
+}
+func (m *QueryAttestationNonceRangeForTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationNonceRangeForTimeRequest) ProtoMessage()    {}
+func (*QueryAttestationNonceRangeForTimeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{6}
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationNonceRangeForTimeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationNonceRangeForTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEarliestAttestationNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationNonceRangeForTimeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,35 +409,58 @@ func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEarliestAttestationNonceRequest.Merge(m, src)
+func (m *QueryAttestationNonceRangeForTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationNonceRangeForTimeRequest.Merge(m, src)
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_Size() int {
+func (m *QueryAttestationNonceRangeForTimeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEarliestAttestationNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEarliestAttestationNonceRequest.DiscardUnknown(m)
+func (m *QueryAttestationNonceRangeForTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationNonceRangeForTimeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEarliestAttestationNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationNonceRangeForTimeRequest proto.InternalMessageInfo
 
-// QueryEarliestAttestationNonceResponse earliest attestation nonce response
-type QueryEarliestAttestationNonceResponse struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QueryAttestationNonceRangeForTimeRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarliestAttestationNonceResponse{} }
-func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
-func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationNonceRangeForTimeRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// QueryAttestationNonceRangeForTimeResponse
+type QueryAttestationNonceRangeForTimeResponse struct {
+	// begin_nonce is the nonce of the earliest attestation created in the time
+	// range.
+	BeginNonce uint64 `protobuf:"varint,1,opt,name=begin_nonce,json=beginNonce,proto3" json:"begin_nonce,omitempty"`
+	// end_nonce is the nonce of the latest attestation created in the time
+	// range.
+	EndNonce uint64 `protobuf:"varint,2,opt,name=end_nonce,json=endNonce,proto3" json:"end_nonce,omitempty"`
+}
+
+func (m *QueryAttestationNonceRangeForTimeResponse) Reset() {
+	*m = QueryAttestationNonceRangeForTimeResponse{}
+}
+func (m *QueryAttestationNonceRangeForTimeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAttestationNonceRangeForTimeResponse) ProtoMessage() {}
+func (*QueryAttestationNonceRangeForTimeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{7}
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationNonceRangeForTimeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationNonceRangeForTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEarliestAttestationNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationNonceRangeForTimeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -353,47 +470,49 @@ func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEarliestAttestationNonceResponse.Merge(m, src)
+func (m *QueryAttestationNonceRangeForTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationNonceRangeForTimeResponse.Merge(m, src)
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_Size() int {
+func (m *QueryAttestationNonceRangeForTimeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEarliestAttestationNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEarliestAttestationNonceResponse.DiscardUnknown(m)
+func (m *QueryAttestationNonceRangeForTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationNonceRangeForTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEarliestAttestationNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationNonceRangeForTimeResponse proto.InternalMessageInfo
 
-func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
+func (m *QueryAttestationNonceRangeForTimeResponse) GetBeginNonce() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.BeginNonce
 	}
 	return 0
 }
 
-// QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
-// universal nonce request
-type QueryLatestValsetRequestBeforeNonceRequest struct {
-	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *QueryAttestationNonceRangeForTimeResponse) GetEndNonce() uint64 {
+	if m != nil {
+		return m.EndNonce
+	}
+	return 0
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Reset() {
-	*m = QueryLatestValsetRequestBeforeNonceRequest{}
-}
-func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
-	return proto.CompactTextString(m)
+// QueryAttestationConfirmsRequest
+type QueryAttestationConfirmsRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
-func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
+
+func (m *QueryAttestationConfirmsRequest) Reset()         { *m = QueryAttestationConfirmsRequest{} }
+func (m *QueryAttestationConfirmsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmsRequest) ProtoMessage()    {}
+func (*QueryAttestationConfirmsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{8}
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationConfirmsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationConfirmsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationConfirmsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -403,47 +522,42 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Merge(m, src)
+func (m *QueryAttestationConfirmsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmsRequest.Merge(m, src)
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Size() int {
+func (m *QueryAttestationConfirmsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.DiscardUnknown(m)
+func (m *QueryAttestationConfirmsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationConfirmsRequest proto.InternalMessageInfo
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) GetNonce() uint64 {
+func (m *QueryAttestationConfirmsRequest) GetNonce() uint64 {
 	if m != nil {
 		return m.Nonce
 	}
 	return 0
 }
 
-// QueryLatestValsetRequestBeforeNonceResponse latest Valset request before
-// height response
-type QueryLatestValsetRequestBeforeNonceResponse struct {
-	Valset *Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset,omitempty"`
+// QueryAttestationConfirmsResponse
+type QueryAttestationConfirmsResponse struct {
+	Confirms []AttestationConfirm `protobuf:"bytes,1,rep,name=confirms,proto3" json:"confirms"`
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Reset() {
-	*m = QueryLatestValsetRequestBeforeNonceResponse{}
-}
-func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
-func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationConfirmsResponse) Reset()         { *m = QueryAttestationConfirmsResponse{} }
+func (m *QueryAttestationConfirmsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmsResponse) ProtoMessage()    {}
+func (*QueryAttestationConfirmsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{9}
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationConfirmsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationConfirmsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationConfirmsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -453,41 +567,42 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Merge(m, src)
+func (m *QueryAttestationConfirmsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmsResponse.Merge(m, src)
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Size() int {
+func (m *QueryAttestationConfirmsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.DiscardUnknown(m)
+func (m *QueryAttestationConfirmsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationConfirmsResponse proto.InternalMessageInfo
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
+func (m *QueryAttestationConfirmsResponse) GetConfirms() []AttestationConfirm {
 	if m != nil {
-		return m.Valset
+		return m.Confirms
 	}
 	return nil
 }
 
-// QueryLatestUnbondingHeightRequest
-type QueryLatestUnbondingHeightRequest struct {
+// QueryAttestationConfirmPowerRequest
+type QueryAttestationConfirmPowerRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
-func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationConfirmPowerRequest) Reset()         { *m = QueryAttestationConfirmPowerRequest{} }
+func (m *QueryAttestationConfirmPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmPowerRequest) ProtoMessage()    {}
+func (*QueryAttestationConfirmPowerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{10}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationConfirmPowerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationConfirmPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationConfirmPowerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -497,35 +612,52 @@ func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+func (m *QueryAttestationConfirmPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmPowerRequest.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+func (m *QueryAttestationConfirmPowerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
+func (m *QueryAttestationConfirmPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmPowerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationConfirmPowerRequest proto.InternalMessageInfo
 
-// QueryLatestUnbondingHeightResponse
-type QueryLatestUnbondingHeightResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *QueryAttestationConfirmPowerRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
-func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
-func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
+// QueryAttestationConfirmPowerResponse
+type QueryAttestationConfirmPowerResponse struct {
+	// power is the sum of the powers of the validators that signed the
+	// attestation.
+	Power uint64 `protobuf:"varint,1,opt,name=power,proto3" json:"power,omitempty"`
+	// threshold is the power threshold of the validator set that the
+	// attestation is checked against.
+	Threshold uint64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// threshold_reached is true when power is at least threshold.
+	ThresholdReached bool `protobuf:"varint,3,opt,name=threshold_reached,json=thresholdReached,proto3" json:"threshold_reached,omitempty"`
+	// valset_nonce is the nonce of the validator set that the attestation is
+	// checked against.
+	ValsetNonce uint64 `protobuf:"varint,4,opt,name=valset_nonce,json=valsetNonce,proto3" json:"valset_nonce,omitempty"`
+}
+
+func (m *QueryAttestationConfirmPowerResponse) Reset()         { *m = QueryAttestationConfirmPowerResponse{} }
+func (m *QueryAttestationConfirmPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationConfirmPowerResponse) ProtoMessage()    {}
+func (*QueryAttestationConfirmPowerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{11}
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAttestationConfirmPowerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAttestationConfirmPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAttestationConfirmPowerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -535,41 +667,62 @@ func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
+func (m *QueryAttestationConfirmPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationConfirmPowerResponse.Merge(m, src)
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+func (m *QueryAttestationConfirmPowerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
+func (m *QueryAttestationConfirmPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationConfirmPowerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAttestationConfirmPowerResponse proto.InternalMessageInfo
 
-func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+func (m *QueryAttestationConfirmPowerResponse) GetPower() uint64 {
 	if m != nil {
-		return m.Height
+		return m.Power
 	}
 	return 0
 }
 
-// QueryLatestDataCommitmentRequest
-type QueryLatestDataCommitmentRequest struct {
+func (m *QueryAttestationConfirmPowerResponse) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
 }
 
-func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
-func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAttestationConfirmPowerResponse) GetThresholdReached() bool {
+	if m != nil {
+		return m.ThresholdReached
+	}
+	return false
+}
+
+func (m *QueryAttestationConfirmPowerResponse) GetValsetNonce() uint64 {
+	if m != nil {
+		return m.ValsetNonce
+	}
+	return 0
+}
+
+// QueryLatestAttestationNonceRequest latest attestation nonce request
+type QueryLatestAttestationNonceRequest struct {
+}
+
+func (m *QueryLatestAttestationNonceRequest) Reset()         { *m = QueryLatestAttestationNonceRequest{} }
+func (m *QueryLatestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestAttestationNonceRequest) ProtoMessage()    {}
+func (*QueryLatestAttestationNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{12}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestAttestationNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -579,35 +732,35 @@ func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
+func (m *QueryLatestAttestationNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestAttestationNonceRequest.Merge(m, src)
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+func (m *QueryLatestAttestationNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
+func (m *QueryLatestAttestationNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestAttestationNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestAttestationNonceRequest proto.InternalMessageInfo
 
-// QueryLatestDataCommitmentResponse
-type QueryLatestDataCommitmentResponse struct {
-	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+// QueryLatestAttestationNonceResponse latest attestation nonce response
+type QueryLatestAttestationNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
-func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
-func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLatestAttestationNonceResponse) Reset()         { *m = QueryLatestAttestationNonceResponse{} }
+func (m *QueryLatestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestAttestationNonceResponse) ProtoMessage()    {}
+func (*QueryLatestAttestationNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{13}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestAttestationNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -617,44 +770,41 @@ func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
+func (m *QueryLatestAttestationNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestAttestationNonceResponse.Merge(m, src)
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+func (m *QueryLatestAttestationNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
+func (m *QueryLatestAttestationNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestAttestationNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestAttestationNonceResponse proto.InternalMessageInfo
 
-func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryLatestAttestationNonceResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.DataCommitment
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// QueryDataCommitmentRangeForHeightRequest
-type QueryDataCommitmentRangeForHeightRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+// QueryEarliestAttestationNonceRequest earliest attestation nonce request
+type QueryEarliestAttestationNonceRequest struct {
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
-	*m = QueryDataCommitmentRangeForHeightRequest{}
-}
-func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
-func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEarliestAttestationNonceRequest) Reset()         { *m = QueryEarliestAttestationNonceRequest{} }
+func (m *QueryEarliestAttestationNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestAttestationNonceRequest) ProtoMessage()    {}
+func (*QueryEarliestAttestationNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{14}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEarliestAttestationNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -664,46 +814,35 @@ func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+func (m *QueryEarliestAttestationNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestAttestationNonceRequest.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+func (m *QueryEarliestAttestationNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
+func (m *QueryEarliestAttestationNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestAttestationNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
-
-func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
-}
+var xxx_messageInfo_QueryEarliestAttestationNonceRequest proto.InternalMessageInfo
 
-// QueryDataCommitmentRangeForHeightResponse
-type QueryDataCommitmentRangeForHeightResponse struct {
-	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+// QueryEarliestAttestationNonceResponse earliest attestation nonce response
+type QueryEarliestAttestationNonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
-	*m = QueryDataCommitmentRangeForHeightResponse{}
-}
-func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
-func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEarliestAttestationNonceResponse) Reset()         { *m = QueryEarliestAttestationNonceResponse{} }
+func (m *QueryEarliestAttestationNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEarliestAttestationNonceResponse) ProtoMessage()    {}
+func (*QueryEarliestAttestationNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{15}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEarliestAttestationNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -713,42 +852,47 @@ func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
+func (m *QueryEarliestAttestationNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEarliestAttestationNonceResponse.Merge(m, src)
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+func (m *QueryEarliestAttestationNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
+func (m *QueryEarliestAttestationNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEarliestAttestationNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEarliestAttestationNonceResponse proto.InternalMessageInfo
 
-func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+func (m *QueryEarliestAttestationNonceResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.DataCommitment
+		return m.Nonce
 	}
-	return nil
+	return 0
 }
 
-// QueryEVMAddressRequest
-type QueryEVMAddressRequest struct {
-	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+// QueryLatestValsetRequestBeforeNonceRequest latest Valset request before
+// universal nonce request
+type QueryLatestValsetRequestBeforeNonceRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
-func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressRequest) ProtoMessage()    {}
-func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Reset() {
+	*m = QueryLatestValsetRequestBeforeNonceRequest{}
+}
+func (m *QueryLatestValsetRequestBeforeNonceRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLatestValsetRequestBeforeNonceRequest) ProtoMessage() {}
+func (*QueryLatestValsetRequestBeforeNonceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{16}
 }
-func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,42 +902,47 @@ func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.Merge(m, src)
 }
-func (m *QueryEVMAddressRequest) XXX_Size() int {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
+func (m *QueryLatestValsetRequestBeforeNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceRequest proto.InternalMessageInfo
 
-func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) GetNonce() uint64 {
 	if m != nil {
-		return m.ValidatorAddress
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-// QueryEVMAddressResponse
-type QueryEVMAddressResponse struct {
-	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+// QueryLatestValsetRequestBeforeNonceResponse latest Valset request before
+// height response
+type QueryLatestValsetRequestBeforeNonceResponse struct {
+	Valset *Valset `protobuf:"bytes,1,opt,name=valset,proto3" json:"valset,omitempty"`
 }
 
-func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
-func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEVMAddressResponse) ProtoMessage()    {}
-func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Reset() {
+	*m = QueryLatestValsetRequestBeforeNonceResponse{}
+}
+func (m *QueryLatestValsetRequestBeforeNonceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLatestValsetRequestBeforeNonceResponse) ProtoMessage() {}
+func (*QueryLatestValsetRequestBeforeNonceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c8535c57355a2b91, []int{17}
 }
-func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -803,1249 +952,4613 @@ func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.Merge(m, src)
 }
-func (m *QueryEVMAddressResponse) XXX_Size() int {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
+func (m *QueryLatestValsetRequestBeforeNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLatestValsetRequestBeforeNonceResponse proto.InternalMessageInfo
 
-func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) GetValset() *Valset {
 	if m != nil {
-		return m.EvmAddress
+		return m.Valset
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
-	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
-	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
-	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
-	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
-	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
-	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
-	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
-	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
-	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
-	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
-	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
-	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
-	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+// QueryLatestUnbondingHeightRequest
+type QueryLatestUnbondingHeightRequest struct {
 }
 
-func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
-
-var fileDescriptor_c8535c57355a2b91 = []byte{
-	// 910 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0x63, 0xd4, 0x46, 0xe2, 0x45, 0xea, 0x8f, 0x49, 0x9a, 0xed, 0xba, 0xc5, 0xbb, 0x75,
-	0xb2, 0xe9, 0x96, 0x65, 0x3d, 0xda, 0x2c, 0x5d, 0x44, 0x5b, 0x0e, 0x1b, 0x28, 0x2a, 0x52, 0x81,
-	0x12, 0x89, 0x1e, 0x38, 0xb0, 0x1a, 0x27, 0x53, 0xaf, 0x45, 0xec, 0x49, 0x6c, 0x27, 0x22, 0x02,
-	0x2e, 0xfc, 0x05, 0x48, 0x1c, 0x39, 0x73, 0xe5, 0x84, 0xb8, 0x70, 0xe4, 0x52, 0xf5, 0x54, 0x89,
-	0x0b, 0x27, 0x84, 0x76, 0xf9, 0x0f, 0xf8, 0x07, 0x50, 0xe6, 0x47, 0xd6, 0x71, 0x6c, 0x27, 0x59,
-	0xf5, 0xe6, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0x9e, 0x33, 0xfe, 0x4e, 0xe0, 0x46, 0x87, 0xf6, 0x68,
-	0x18, 0xb9, 0x04, 0x0f, 0x1c, 0x1b, 0x8f, 0xf6, 0xf0, 0x60, 0x48, 0x83, 0xb1, 0xd5, 0x0f, 0x58,
-	0xc4, 0xd0, 0x65, 0x15, 0xb4, 0x06, 0x8e, 0x6d, 0x8d, 0xf6, 0xf4, 0x37, 0x92, 0xd9, 0x0e, 0xf5,
-	0x69, 0xe8, 0x86, 0x22, 0x5f, 0x9f, 0x13, 0x8b, 0xc6, 0x7d, 0xaa, 0x82, 0x37, 0x1d, 0xc6, 0x9c,
-	0x1e, 0xc5, 0xa4, 0xef, 0x62, 0xe2, 0xfb, 0x2c, 0x22, 0x91, 0xcb, 0x7c, 0x15, 0xad, 0x38, 0xcc,
-	0x61, 0xfc, 0x11, 0x4f, 0x9e, 0xe4, 0xee, 0x7a, 0x87, 0x85, 0x1e, 0x0b, 0x8f, 0x44, 0x40, 0x2c,
-	0x54, 0x48, 0xca, 0xf1, 0x95, 0x3d, 0x7c, 0x86, 0x89, 0x2f, 0xb1, 0xcd, 0x0a, 0xa0, 0xcf, 0x26,
-	0x53, 0x3c, 0x21, 0x01, 0xf1, 0xc2, 0x36, 0x1d, 0x0c, 0x69, 0x18, 0x99, 0x8f, 0xa1, 0x3c, 0xb3,
-	0x1b, 0xf6, 0x99, 0x1f, 0x52, 0x74, 0x17, 0x8a, 0x7d, 0xbe, 0x73, 0x5d, 0xdb, 0xd4, 0xb6, 0x4b,
-	0xcd, 0x35, 0x2b, 0x31, 0xb4, 0x25, 0x0a, 0x5a, 0x17, 0x9e, 0xff, 0xbd, 0x51, 0x68, 0xcb, 0x64,
-	0xf3, 0x3d, 0xd8, 0xe2, 0x6a, 0x87, 0x51, 0x44, 0x43, 0x31, 0x8a, 0x6c, 0xd4, 0x1a, 0x7f, 0xc2,
-	0xfc, 0x0e, 0x95, 0x2b, 0x54, 0x81, 0x8b, 0xfe, 0x64, 0xcd, 0xe5, 0x2f, 0xb4, 0xc5, 0xc2, 0x1c,
-	0x43, 0x63, 0x51, 0xb9, 0xe4, 0xfb, 0x14, 0x4a, 0xe4, 0x2c, 0x49, 0x42, 0x56, 0x2c, 0x31, 0xbd,
-	0xa5, 0xa6, 0xb7, 0x0e, 0xfd, 0x71, 0x6b, 0xed, 0xc5, 0xaf, 0xbb, 0xe5, 0x79, 0xc5, 0x8f, 0xda,
-	0x71, 0x05, 0xb3, 0x0e, 0x26, 0x6f, 0xfd, 0x98, 0x4c, 0xf6, 0x62, 0xe9, 0x71, 0x6c, 0xf3, 0x3e,
-	0xd4, 0x72, 0xb3, 0x24, 0x5d, 0xfa, 0x74, 0x0d, 0xa8, 0xf3, 0xe2, 0x87, 0x24, 0xe8, 0xb9, 0x39,
-	0x4d, 0xd4, 0x4b, 0xcc, 0xce, 0xcb, 0x6d, 0xd3, 0x82, 0x37, 0x63, 0x8c, 0x4f, 0x49, 0x2f, 0xa4,
-	0x91, 0x7a, 0x8b, 0xf4, 0x19, 0x0b, 0xe8, 0x12, 0x3f, 0xc4, 0x97, 0xb0, 0xb3, 0x94, 0x86, 0x04,
-	0xc1, 0x50, 0x1c, 0xf1, 0x9c, 0xcc, 0xd3, 0x22, 0x25, 0x64, 0x9a, 0x59, 0x83, 0x5b, 0x31, 0xfd,
-	0xcf, 0x7d, 0x9b, 0xf9, 0x5d, 0xd7, 0x77, 0x1e, 0x51, 0xd7, 0x39, 0x56, 0x8d, 0xcc, 0x07, 0x33,
-	0x3f, 0xc9, 0x5c, 0x92, 0xec, 0x5d, 0x85, 0xe2, 0x31, 0xdf, 0x91, 0x13, 0xc8, 0x95, 0x69, 0xc2,
-	0x66, 0xac, 0xfa, 0x03, 0x12, 0x91, 0xf7, 0x99, 0xe7, 0xb9, 0x91, 0x47, 0xfd, 0x69, 0x07, 0x6f,
-	0x06, 0x23, 0x99, 0x23, 0x1b, 0x3c, 0x82, 0xcb, 0x5d, 0x12, 0x91, 0xa3, 0xce, 0x34, 0x24, 0xa7,
-	0xdc, 0x98, 0x9b, 0x32, 0xa1, 0x70, 0xa9, 0x3b, 0xb3, 0x36, 0x5b, 0xb0, 0xcd, 0xdb, 0x25, 0xd2,
-	0x88, 0xef, 0xd0, 0x0f, 0x59, 0x30, 0x33, 0x7c, 0xe6, 0x58, 0x43, 0xb8, 0xb3, 0x84, 0xc6, 0x2b,
-	0x47, 0x7f, 0x08, 0x55, 0x71, 0x26, 0x9f, 0x7e, 0x7c, 0xd8, 0xed, 0x06, 0x34, 0x54, 0x06, 0x82,
-	0x76, 0xe0, 0xea, 0x88, 0xf4, 0xdc, 0x2e, 0x89, 0x58, 0x70, 0x44, 0x44, 0x8c, 0x77, 0x79, 0xbd,
-	0x7d, 0x65, 0x1a, 0x90, 0x35, 0xe6, 0x3d, 0x58, 0x9b, 0x93, 0x91, 0xac, 0x1b, 0x50, 0xa2, 0x23,
-	0x2f, 0xa1, 0x00, 0x74, 0xe4, 0xc9, 0xc4, 0xe6, 0x7f, 0x25, 0xb8, 0xc8, 0x8b, 0xd1, 0x57, 0x50,
-	0x14, 0xee, 0x83, 0x6a, 0x73, 0x73, 0xcc, 0x5b, 0x9c, 0x5e, 0xcf, 0x4f, 0x12, 0xfd, 0xcd, 0xea,
-	0xf7, 0x7f, 0xfe, 0xfb, 0xe3, 0x6b, 0x57, 0xd0, 0x25, 0xe5, 0xd2, 0xc2, 0xd2, 0xd0, 0xef, 0x1a,
-	0xac, 0x67, 0xfa, 0x11, 0x3a, 0x48, 0xd7, 0x5e, 0xe4, 0x7f, 0xfa, 0x3b, 0x2b, 0xd7, 0x49, 0xcc,
-	0x5d, 0x8e, 0x79, 0x1b, 0x6d, 0x29, 0xcc, 0x98, 0x89, 0x85, 0x38, 0x10, 0x45, 0x21, 0xfe, 0x86,
-	0x7f, 0xc7, 0xdf, 0xa1, 0x5f, 0x34, 0xa8, 0xa6, 0x9b, 0x15, 0xda, 0x4f, 0x47, 0xc8, 0x35, 0x40,
-	0xfd, 0xed, 0xd5, 0x8a, 0x24, 0xf4, 0x1d, 0x0e, 0x5d, 0x43, 0xb7, 0x52, 0xa1, 0x39, 0x2a, 0xee,
-	0x71, 0x09, 0xf4, 0x9b, 0x06, 0xd7, 0xb3, 0x8c, 0x0f, 0xdd, 0x4d, 0xef, 0xbe, 0xc0, 0x50, 0xf5,
-	0x83, 0x55, 0xcb, 0x24, 0xf6, 0x0e, 0xc7, 0xde, 0x42, 0xb5, 0x1c, 0x6c, 0x2a, 0x45, 0xd0, 0x0b,
-	0x0d, 0x8c, 0x7c, 0xbb, 0x44, 0xf7, 0xf3, 0x5e, 0xde, 0x02, 0xa3, 0xd6, 0x1f, 0x9c, 0xaf, 0x38,
-	0xeb, 0xd8, 0x08, 0x23, 0x56, 0x07, 0x06, 0xdb, 0xbc, 0x66, 0x7a, 0x6c, 0x7e, 0xd2, 0xe0, 0x5a,
-	0xaa, 0xed, 0xa2, 0x66, 0x1e, 0x46, 0xba, 0x91, 0xeb, 0xfb, 0x2b, 0xd5, 0x48, 0xe2, 0x75, 0x4e,
-	0x5c, 0x46, 0x57, 0x15, 0xf1, 0x50, 0x25, 0xa2, 0x3f, 0x34, 0xb8, 0x99, 0xe7, 0x7f, 0xe8, 0xdd,
-	0xf4, 0x86, 0x4b, 0xf8, 0xae, 0x7e, 0xef, 0x3c, 0xa5, 0x12, 0xf9, 0x2d, 0x8e, 0xdc, 0x40, 0x75,
-	0x85, 0x9c, 0x30, 0x5f, 0x1c, 0x4c, 0xea, 0xb0, 0x70, 0x72, 0xf4, 0xb3, 0x06, 0x95, 0xb4, 0x8b,
-	0x07, 0xed, 0xe5, 0xbd, 0xae, 0xd4, 0x8b, 0x4c, 0x6f, 0xae, 0x52, 0x22, 0x69, 0x1b, 0x9c, 0x76,
-	0x13, 0x19, 0x59, 0xb4, 0xf2, 0x8b, 0xfc, 0x16, 0xe0, 0xcc, 0xae, 0xd1, 0xed, 0x8c, 0x6f, 0x29,
-	0x79, 0x2f, 0xe8, 0xdb, 0x8b, 0x13, 0x25, 0xc8, 0x0d, 0x0e, 0x72, 0x0d, 0x95, 0x15, 0x48, 0xec,
-	0x1e, 0x68, 0x3d, 0x79, 0x7e, 0x62, 0x68, 0x2f, 0x4f, 0x0c, 0xed, 0x9f, 0x13, 0x43, 0xfb, 0xe1,
-	0xd4, 0x28, 0xbc, 0x3c, 0x35, 0x0a, 0x7f, 0x9d, 0x1a, 0x85, 0x2f, 0x0e, 0x1c, 0x37, 0x3a, 0x1e,
-	0xda, 0x56, 0x87, 0x79, 0x58, 0xb5, 0x62, 0x81, 0x33, 0x7d, 0xde, 0x25, 0xfd, 0x3e, 0xfe, 0x1a,
-	0xdb, 0x3d, 0x66, 0x87, 0x51, 0x40, 0x89, 0x27, 0xfe, 0x77, 0xdb, 0x45, 0xfe, 0xef, 0x70, 0xff,
-	0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x42, 0x6c, 0x12, 0x89, 0xe4, 0x0b, 0x00, 0x00,
+func (m *QueryLatestUnbondingHeightRequest) Reset()         { *m = QueryLatestUnbondingHeightRequest{} }
+func (m *QueryLatestUnbondingHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightRequest) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{18}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.Merge(m, src)
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLatestUnbondingHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryLatestUnbondingHeightRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the current parameters for the blobstream module
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
-	// EVMAddress returns the evm address associated with a supplied
-	// validator address
-	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+// QueryLatestUnbondingHeightResponse
+type QueryLatestUnbondingHeightResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLatestUnbondingHeightResponse) Reset()         { *m = QueryLatestUnbondingHeightResponse{} }
+func (m *QueryLatestUnbondingHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestUnbondingHeightResponse) ProtoMessage()    {}
+func (*QueryLatestUnbondingHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{19}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLatestUnbondingHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestUnbondingHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestUnbondingHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
-	out := new(QueryAttestationRequestByNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.Merge(m, src)
 }
-
-func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
-	out := new(QueryLatestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
-	out := new(QueryEarliestAttestationNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EarliestAttestationNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestUnbondingHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestUnbondingHeightResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	out := new(QueryLatestValsetRequestBeforeNonceResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryLatestUnbondingHeightResponse proto.InternalMessageInfo
 
-func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
-	out := new(QueryLatestUnbondingHeightResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestUnbondingHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	out := new(QueryDataCommitmentRangeForHeightResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryLatestDataCommitmentRequest
+type QueryLatestDataCommitmentRequest struct {
 }
 
-func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
-	out := new(QueryLatestDataCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestDataCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLatestDataCommitmentRequest) Reset()         { *m = QueryLatestDataCommitmentRequest{} }
+func (m *QueryLatestDataCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentRequest) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{20}
 }
-
-func (c *queryClient) EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error) {
-	out := new(QueryEVMAddressResponse)
-	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLatestDataCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLatestDataCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the current parameters for the blobstream module
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// AttestationRequestByNonce queries attestation request by nonce.
-	// Returns nil if not found.
-	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
-	// LatestAttestationNonce queries latest attestation nonce.
-	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
-	// EarliestAttestationNonce queries the earliest attestation nonce.
-	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
-	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
-	// And, even if the current nonce is a valset, it will return the previous
-	// one.
-	// If the provided nonce is 1, it will return an error, because, there is
-	// no valset before nonce 1.
-	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
-	// LatestUnbondingHeight returns the latest unbonding height
-	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
-	// DataCommitmentRangeForHeight returns the data commitment window
-	// that includes the provided height
-	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
-	// LatestDataCommitment returns the latest data commitment in store
-	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
-	// EVMAddress returns the evm address associated with a supplied
-	// validator address
-	EVMAddress(context.Context, *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error)
+func (m *QueryLatestDataCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLatestDataCommitmentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLatestDataCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
+
+var xxx_messageInfo_QueryLatestDataCommitmentRequest proto.InternalMessageInfo
+
+// QueryLatestDataCommitmentResponse
+type QueryLatestDataCommitmentResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
-func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
+
+func (m *QueryLatestDataCommitmentResponse) Reset()         { *m = QueryLatestDataCommitmentResponse{} }
+func (m *QueryLatestDataCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLatestDataCommitmentResponse) ProtoMessage()    {}
+func (*QueryLatestDataCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{21}
 }
-func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLatestDataCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+func (m *QueryLatestDataCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLatestDataCommitmentResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) EVMAddress(ctx context.Context, req *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EVMAddress not implemented")
+
+var xxx_messageInfo_QueryLatestDataCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryLatestDataCommitmentResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryDataCommitmentRangeForHeightRequest
+type QueryDataCommitmentRangeForHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryDataCommitmentRangeForHeightRequest) Reset() {
+	*m = QueryDataCommitmentRangeForHeightRequest{}
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataCommitmentRangeForHeightRequest) ProtoMessage()    {}
+func (*QueryDataCommitmentRangeForHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{22}
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.Merge(m, src)
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRangeForHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest.DiscardUnknown(m)
 }
 
-func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttestationRequestByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/AttestationRequestByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightRequest proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRangeForHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDataCommitmentRangeForHeightResponse
+type QueryDataCommitmentRangeForHeightResponse struct {
+	DataCommitment *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
 }
 
-func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEarliestAttestationNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/EarliestAttestationNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
+func (m *QueryDataCommitmentRangeForHeightResponse) Reset() {
+	*m = QueryDataCommitmentRangeForHeightResponse{}
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryDataCommitmentRangeForHeightResponse) ProtoMessage() {}
+func (*QueryDataCommitmentRangeForHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{23}
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.Merge(m, src)
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataCommitmentRangeForHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse.DiscardUnknown(m)
 }
 
-func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestValsetRequestBeforeNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
+var xxx_messageInfo_QueryDataCommitmentRangeForHeightResponse proto.InternalMessageInfo
+
+func (m *QueryDataCommitmentRangeForHeightResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestUnbondingHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestUnbondingHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDataRootTupleRootRequest
+type QueryDataRootTupleRootRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDataCommitmentRangeForHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
+func (m *QueryDataRootTupleRootRequest) Reset()         { *m = QueryDataRootTupleRootRequest{} }
+func (m *QueryDataRootTupleRootRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleRootRequest) ProtoMessage()    {}
+func (*QueryDataRootTupleRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{24}
+}
+func (m *QueryDataRootTupleRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDataRootTupleRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleRootRequest.Merge(m, src)
+}
+func (m *QueryDataRootTupleRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleRootRequest.DiscardUnknown(m)
 }
 
-func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLatestDataCommitmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestDataCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/LatestDataCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
+var xxx_messageInfo_QueryDataRootTupleRootRequest proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleRootRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_EVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEVMAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EVMAddress(ctx, in)
+// QueryDataRootTupleRootResponse
+type QueryDataRootTupleRootResponse struct {
+	DataCommitment    *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	DataRootTupleRoot []byte          `protobuf:"bytes,2,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+}
+
+func (m *QueryDataRootTupleRootResponse) Reset()         { *m = QueryDataRootTupleRootResponse{} }
+func (m *QueryDataRootTupleRootResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleRootResponse) ProtoMessage()    {}
+func (*QueryDataRootTupleRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{25}
+}
+func (m *QueryDataRootTupleRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.qgb.v1.Query/EVMAddress",
+}
+func (m *QueryDataRootTupleRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleRootResponse.Merge(m, src)
+}
+func (m *QueryDataRootTupleRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDataRootTupleRootResponse proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleRootResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EVMAddress(ctx, req.(*QueryEVMAddressRequest))
+	return nil
+}
+
+func (m *QueryDataRootTupleRootResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.qgb.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AttestationRequestByNonce",
-			Handler:    _Query_AttestationRequestByNonce_Handler,
-		},
-		{
-			MethodName: "LatestAttestationNonce",
-			Handler:    _Query_LatestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "EarliestAttestationNonce",
-			Handler:    _Query_EarliestAttestationNonce_Handler,
-		},
-		{
-			MethodName: "LatestValsetRequestBeforeNonce",
-			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
-		},
-		{
-			MethodName: "LatestUnbondingHeight",
-			Handler:    _Query_LatestUnbondingHeight_Handler,
-		},
-		{
-			MethodName: "DataCommitmentRangeForHeight",
-			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
-		},
-		{
-			MethodName: "LatestDataCommitment",
-			Handler:    _Query_LatestDataCommitment_Handler,
-		},
-		{
-			MethodName: "EVMAddress",
-			Handler:    _Query_EVMAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/qgb/v1/query.proto",
+// QueryDataRootTupleInclusionProofRequest
+type QueryDataRootTupleInclusionProofRequest struct {
+	Nonce  uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDataRootTupleInclusionProofRequest) Reset() {
+	*m = QueryDataRootTupleInclusionProofRequest{}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofRequest) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{26}
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.Merge(m, src)
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryDataRootTupleInclusionProofRequest proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleInclusionProofRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDataRootTupleInclusionProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDataRootTupleInclusionProofResponse
+type QueryDataRootTupleInclusionProofResponse struct {
+	DataCommitment    *DataCommitment `protobuf:"bytes,1,opt,name=data_commitment,json=dataCommitment,proto3" json:"data_commitment,omitempty"`
+	DataRootTupleRoot []byte          `protobuf:"bytes,2,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// data_root is the data root of the block at the requested height.
+	DataRoot []byte       `protobuf:"bytes,3,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	Proof    crypto.Proof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDataRootTupleInclusionProofResponse) Reset() {
+	*m = QueryDataRootTupleInclusionProofResponse{}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDataRootTupleInclusionProofResponse) ProtoMessage()    {}
+func (*QueryDataRootTupleInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{27}
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.Merge(m, src)
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDataRootTupleInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDataRootTupleInclusionProofResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDataRootTupleInclusionProofResponse proto.InternalMessageInfo
+
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataCommitment() *DataCommitment {
+	if m != nil {
+		return m.DataCommitment
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
 }
 
-func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryDataRootTupleInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDataRootTupleInclusionProofResponse) GetProof() crypto.Proof {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return crypto.Proof{}
 }
 
-func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressRequest
+type QueryEVMAddressRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		{
-			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEVMAddressRequest) Reset()         { *m = QueryEVMAddressRequest{} }
+func (m *QueryEVMAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressRequest) ProtoMessage()    {}
+func (*QueryEVMAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{28}
+}
+func (m *QueryEVMAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEVMAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressRequest.Merge(m, src)
 }
-
-func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEVMAddressRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEVMAddressRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressResponse
+type QueryEVMAddressResponse struct {
+	EvmAddress string `protobuf:"bytes,1,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
 }
 
-func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressResponse) Reset()         { *m = QueryEVMAddressResponse{} }
+func (m *QueryEVMAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressResponse) ProtoMessage()    {}
+func (*QueryEVMAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{29}
 }
-
-func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEVMAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEVMAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressResponse.Merge(m, src)
 }
-
-func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEVMAddressResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressResponse) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressAtHeightRequest
+type QueryEVMAddressAtHeightRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressAtHeightRequest) Reset()         { *m = QueryEVMAddressAtHeightRequest{} }
+func (m *QueryEVMAddressAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressAtHeightRequest) ProtoMessage()    {}
+func (*QueryEVMAddressAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{30}
 }
-
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEVMAddressAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEVMAddressAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressAtHeightRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressAtHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryEVMAddressAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressAtHeightRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEVMAddressAtHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressAtHeightResponse
+type QueryEVMAddressAtHeightResponse struct {
+	Record EVMAddressRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valset != nil {
-		{
-			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEVMAddressAtHeightResponse) Reset()         { *m = QueryEVMAddressAtHeightResponse{} }
+func (m *QueryEVMAddressAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressAtHeightResponse) ProtoMessage()    {}
+func (*QueryEVMAddressAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{31}
+}
+func (m *QueryEVMAddressAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEVMAddressAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressAtHeightResponse.Merge(m, src)
 }
-
-func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEVMAddressAtHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressAtHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEVMAddressAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressAtHeightResponse) GetRecord() EVMAddressRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return EVMAddressRecord{}
 }
 
-func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressHistoryRequest
+type QueryEVMAddressHistoryRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryEVMAddressHistoryRequest) Reset()         { *m = QueryEVMAddressHistoryRequest{} }
+func (m *QueryEVMAddressHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressHistoryRequest) ProtoMessage()    {}
+func (*QueryEVMAddressHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{32}
+}
+func (m *QueryEVMAddressHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEVMAddressHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressHistoryRequest.Merge(m, src)
+}
+func (m *QueryEVMAddressHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEVMAddressHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryEVMAddressHistoryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEVMAddressHistoryResponse
+type QueryEVMAddressHistoryResponse struct {
+	Records []EVMAddressRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEVMAddressHistoryResponse) Reset()         { *m = QueryEVMAddressHistoryResponse{} }
+func (m *QueryEVMAddressHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEVMAddressHistoryResponse) ProtoMessage()    {}
+func (*QueryEVMAddressHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8535c57355a2b91, []int{33}
 }
-
-func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEVMAddressHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEVMAddressHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEVMAddressHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEVMAddressHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEVMAddressHistoryResponse.Merge(m, src)
+}
+func (m *QueryEVMAddressHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEVMAddressHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEVMAddressHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEVMAddressHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryEVMAddressHistoryResponse) GetRecords() []EVMAddressRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterEnum("celestia.qgb.v1.AttestationType", AttestationType_name, AttestationType_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.qgb.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.qgb.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAttestationRequestByNonceRequest)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceRequest")
+	proto.RegisterType((*QueryAttestationRequestByNonceResponse)(nil), "celestia.qgb.v1.QueryAttestationRequestByNonceResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "celestia.qgb.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "celestia.qgb.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryAttestationNonceRangeForTimeRequest)(nil), "celestia.qgb.v1.QueryAttestationNonceRangeForTimeRequest")
+	proto.RegisterType((*QueryAttestationNonceRangeForTimeResponse)(nil), "celestia.qgb.v1.QueryAttestationNonceRangeForTimeResponse")
+	proto.RegisterType((*QueryAttestationConfirmsRequest)(nil), "celestia.qgb.v1.QueryAttestationConfirmsRequest")
+	proto.RegisterType((*QueryAttestationConfirmsResponse)(nil), "celestia.qgb.v1.QueryAttestationConfirmsResponse")
+	proto.RegisterType((*QueryAttestationConfirmPowerRequest)(nil), "celestia.qgb.v1.QueryAttestationConfirmPowerRequest")
+	proto.RegisterType((*QueryAttestationConfirmPowerResponse)(nil), "celestia.qgb.v1.QueryAttestationConfirmPowerResponse")
+	proto.RegisterType((*QueryLatestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceRequest")
+	proto.RegisterType((*QueryLatestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryLatestAttestationNonceResponse")
+	proto.RegisterType((*QueryEarliestAttestationNonceRequest)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceRequest")
+	proto.RegisterType((*QueryEarliestAttestationNonceResponse)(nil), "celestia.qgb.v1.QueryEarliestAttestationNonceResponse")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceRequest)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceRequest")
+	proto.RegisterType((*QueryLatestValsetRequestBeforeNonceResponse)(nil), "celestia.qgb.v1.QueryLatestValsetRequestBeforeNonceResponse")
+	proto.RegisterType((*QueryLatestUnbondingHeightRequest)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightRequest")
+	proto.RegisterType((*QueryLatestUnbondingHeightResponse)(nil), "celestia.qgb.v1.QueryLatestUnbondingHeightResponse")
+	proto.RegisterType((*QueryLatestDataCommitmentRequest)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentRequest")
+	proto.RegisterType((*QueryLatestDataCommitmentResponse)(nil), "celestia.qgb.v1.QueryLatestDataCommitmentResponse")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightRequest)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightRequest")
+	proto.RegisterType((*QueryDataCommitmentRangeForHeightResponse)(nil), "celestia.qgb.v1.QueryDataCommitmentRangeForHeightResponse")
+	proto.RegisterType((*QueryDataRootTupleRootRequest)(nil), "celestia.qgb.v1.QueryDataRootTupleRootRequest")
+	proto.RegisterType((*QueryDataRootTupleRootResponse)(nil), "celestia.qgb.v1.QueryDataRootTupleRootResponse")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofRequest)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofRequest")
+	proto.RegisterType((*QueryDataRootTupleInclusionProofResponse)(nil), "celestia.qgb.v1.QueryDataRootTupleInclusionProofResponse")
+	proto.RegisterType((*QueryEVMAddressRequest)(nil), "celestia.qgb.v1.QueryEVMAddressRequest")
+	proto.RegisterType((*QueryEVMAddressResponse)(nil), "celestia.qgb.v1.QueryEVMAddressResponse")
+	proto.RegisterType((*QueryEVMAddressAtHeightRequest)(nil), "celestia.qgb.v1.QueryEVMAddressAtHeightRequest")
+	proto.RegisterType((*QueryEVMAddressAtHeightResponse)(nil), "celestia.qgb.v1.QueryEVMAddressAtHeightResponse")
+	proto.RegisterType((*QueryEVMAddressHistoryRequest)(nil), "celestia.qgb.v1.QueryEVMAddressHistoryRequest")
+	proto.RegisterType((*QueryEVMAddressHistoryResponse)(nil), "celestia.qgb.v1.QueryEVMAddressHistoryResponse")
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("celestia/qgb/v1/query.proto", fileDescriptor_c8535c57355a2b91) }
+
+var fileDescriptor_c8535c57355a2b91 = []byte{
+	// 1774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcb, 0x4f, 0x1b, 0x57,
+	0x17, 0x67, 0x08, 0x10, 0x38, 0xa0, 0x04, 0x2e, 0x0e, 0x8f, 0x01, 0x0c, 0x0c, 0x04, 0x08, 0x7c,
+	0x99, 0x09, 0x04, 0xc8, 0x97, 0xc7, 0xa7, 0xc8, 0x10, 0xe7, 0x0b, 0x12, 0x10, 0xe2, 0x38, 0xa9,
+	0xda, 0x45, 0xad, 0xb1, 0x7d, 0x31, 0xa3, 0xda, 0x73, 0xcd, 0xcc, 0xd8, 0xad, 0x95, 0x66, 0xd3,
+	0xfe, 0x03, 0x91, 0xba, 0xaa, 0xb2, 0xab, 0xda, 0x45, 0xbb, 0xa8, 0xaa, 0xaa, 0x6a, 0x17, 0x5d,
+	0xb6, 0x8b, 0x28, 0xab, 0x48, 0x95, 0xa2, 0xae, 0xda, 0x2a, 0xe9, 0x1f, 0xd1, 0x65, 0x35, 0xf7,
+	0xde, 0xf1, 0x63, 0x5e, 0xb6, 0x69, 0xa4, 0xee, 0x7c, 0xef, 0x3d, 0x8f, 0xdf, 0x39, 0xf7, 0x9c,
+	0x3b, 0xe7, 0x07, 0x30, 0x91, 0xc1, 0x79, 0x6c, 0x5a, 0x9a, 0xaa, 0x1c, 0xe7, 0xd2, 0x4a, 0x79,
+	0x55, 0x39, 0x2e, 0x61, 0xa3, 0x22, 0x17, 0x0d, 0x62, 0x11, 0x74, 0xd6, 0x39, 0x94, 0x8f, 0x73,
+	0x69, 0xb9, 0xbc, 0x2a, 0x4e, 0xb9, 0xa5, 0x73, 0x58, 0xc7, 0xa6, 0x66, 0x32, 0x79, 0xd1, 0x63,
+	0xcc, 0xaa, 0x14, 0xb1, 0x73, 0x38, 0x99, 0x23, 0x24, 0x97, 0xc7, 0x8a, 0x5a, 0xd4, 0x14, 0x55,
+	0xd7, 0x89, 0xa5, 0x5a, 0x1a, 0xd1, 0x9d, 0xd3, 0x48, 0x8e, 0xe4, 0x08, 0xfd, 0xa9, 0xd8, 0xbf,
+	0xf8, 0xee, 0x78, 0x86, 0x98, 0x05, 0x62, 0xa6, 0xd8, 0x01, 0x5b, 0x38, 0x47, 0xdc, 0x1c, 0x5d,
+	0xa5, 0x4b, 0x87, 0x8a, 0xaa, 0x73, 0xd8, 0xe2, 0xb4, 0xfb, 0xc8, 0xd2, 0x0a, 0xd8, 0xb4, 0xd4,
+	0x42, 0x91, 0x0b, 0x2c, 0x33, 0x4b, 0x4a, 0x5a, 0x35, 0x31, 0x0b, 0x58, 0x29, 0xaf, 0xa6, 0xb1,
+	0xa5, 0xae, 0x2a, 0x45, 0x35, 0xa7, 0xe9, 0x14, 0x19, 0x97, 0x9d, 0xb2, 0xb0, 0x9e, 0xc5, 0x46,
+	0x41, 0xd3, 0x2d, 0x25, 0x63, 0x54, 0x8a, 0x16, 0xb1, 0xed, 0x92, 0x43, 0x76, 0x2c, 0x45, 0x00,
+	0xdd, 0xb3, 0x0d, 0x1c, 0xa8, 0x86, 0x5a, 0x30, 0x13, 0xf8, 0xb8, 0x84, 0x4d, 0x4b, 0xda, 0x85,
+	0xe1, 0x86, 0x5d, 0xb3, 0x48, 0x74, 0x13, 0xa3, 0x0d, 0xe8, 0x29, 0xd2, 0x9d, 0x31, 0x61, 0x46,
+	0x58, 0xea, 0x5f, 0x1b, 0x95, 0x5d, 0x09, 0x96, 0x99, 0xc2, 0x56, 0xd7, 0xb3, 0xdf, 0xa6, 0x3b,
+	0x12, 0x5c, 0x58, 0xfa, 0x1f, 0x9c, 0xa7, 0xd6, 0x62, 0x96, 0x65, 0x87, 0x61, 0x83, 0xe3, 0x8e,
+	0xb6, 0x2a, 0xfb, 0x44, 0xcf, 0x60, 0xbe, 0x42, 0x11, 0xe8, 0xd6, 0xed, 0x35, 0x35, 0xdf, 0x95,
+	0x60, 0x0b, 0xa9, 0x02, 0x0b, 0xcd, 0xd4, 0x39, 0xbe, 0xbb, 0xd0, 0xaf, 0xd6, 0x84, 0x38, 0xc8,
+	0x88, 0xcc, 0xd2, 0x29, 0x3b, 0xe9, 0x94, 0x63, 0x7a, 0x65, 0x6b, 0xf4, 0xf9, 0x77, 0x17, 0x87,
+	0xbd, 0x16, 0x77, 0x12, 0xf5, 0x16, 0xa4, 0x97, 0x02, 0x8c, 0xb9, 0x7d, 0x3b, 0x49, 0x42, 0xd3,
+	0xd0, 0x9f, 0xc6, 0x39, 0x4d, 0x4f, 0xd5, 0x63, 0x06, 0xba, 0x45, 0x61, 0xa1, 0x09, 0xe8, 0xc3,
+	0x7a, 0x96, 0x1f, 0x77, 0xd2, 0xe3, 0x5e, 0xac, 0x67, 0xd9, 0xe1, 0x3a, 0x74, 0xd9, 0xd5, 0x35,
+	0x76, 0x6a, 0x46, 0x58, 0x3a, 0xb3, 0x36, 0xe3, 0xc9, 0x64, 0x9d, 0xc7, 0x64, 0xa5, 0x88, 0x13,
+	0x54, 0x1a, 0xdd, 0x06, 0xa8, 0xdd, 0xf0, 0x58, 0x17, 0x0d, 0x70, 0x41, 0xe6, 0x85, 0x65, 0x97,
+	0x83, 0xcc, 0xea, 0x9f, 0x97, 0x83, 0x7c, 0xa0, 0xe6, 0x9c, 0xec, 0x26, 0xea, 0x34, 0xa5, 0x1f,
+	0x04, 0x18, 0xf7, 0x09, 0x8c, 0xe7, 0xf1, 0x1e, 0x0c, 0xd4, 0x65, 0xc1, 0xbe, 0xed, 0x53, 0xed,
+	0x27, 0xb2, 0xc1, 0x04, 0xfa, 0x7f, 0x03, 0xf0, 0x4e, 0x0a, 0x7c, 0xb1, 0x29, 0x70, 0x86, 0xa7,
+	0x01, 0xf9, 0x37, 0x02, 0x2c, 0xb9, 0x91, 0xb3, 0x2a, 0x50, 0xf5, 0x1c, 0xbe, 0x4d, 0x8c, 0xa4,
+	0x56, 0xa8, 0x16, 0xd4, 0x36, 0x80, 0x69, 0xa9, 0x86, 0x95, 0xb2, 0x3b, 0x88, 0xd7, 0x83, 0xe8,
+	0x09, 0x23, 0xe9, 0xb4, 0xd7, 0x56, 0xaf, 0x5d, 0xb7, 0x4f, 0x7e, 0x9f, 0x16, 0x12, 0x7d, 0x54,
+	0xcf, 0x3e, 0x41, 0x37, 0xc1, 0xbe, 0x35, 0x66, 0xa2, 0xb3, 0x0d, 0x13, 0xa7, 0xb1, 0x9e, 0xb5,
+	0xf7, 0x25, 0x0d, 0x2e, 0xb4, 0x80, 0x98, 0xe7, 0xfe, 0x1f, 0x55, 0x95, 0x74, 0x05, 0xa6, 0xdd,
+	0xae, 0xb6, 0x89, 0x7e, 0xa8, 0x19, 0xd5, 0xde, 0x0e, 0x68, 0x32, 0x0d, 0x66, 0x82, 0x15, 0x39,
+	0xb4, 0x38, 0xf4, 0x66, 0xf8, 0x1e, 0x2f, 0x89, 0xb9, 0xb0, 0xb2, 0xe5, 0xfa, 0xfc, 0x31, 0xa8,
+	0xaa, 0x4a, 0xd7, 0x61, 0x2e, 0xc0, 0xd5, 0x01, 0x79, 0x1f, 0x1b, 0xe1, 0x38, 0xbf, 0x12, 0x60,
+	0x3e, 0x5c, 0x9b, 0x83, 0x8d, 0x40, 0x77, 0xd1, 0xde, 0x70, 0xd4, 0xe9, 0x02, 0x4d, 0x42, 0x9f,
+	0x75, 0x64, 0x60, 0xf3, 0x88, 0xe4, 0xb3, 0x3c, 0x79, 0xb5, 0x0d, 0xb4, 0x02, 0x43, 0xd5, 0x45,
+	0xca, 0xc0, 0x6a, 0xe6, 0x08, 0x67, 0x69, 0x83, 0xf6, 0x26, 0x06, 0xab, 0x07, 0x09, 0xb6, 0x8f,
+	0x66, 0x61, 0xa0, 0xac, 0xe6, 0x4d, 0x6c, 0xf1, 0xab, 0xe8, 0xa2, 0xd6, 0xfa, 0xd9, 0x1e, 0xbb,
+	0x8d, 0x79, 0x90, 0x28, 0xd6, 0x5d, 0xd5, 0xc6, 0xea, 0xb9, 0x7e, 0xfe, 0xd8, 0x3a, 0xf9, 0x08,
+	0x92, 0xaa, 0x05, 0xe4, 0x93, 0x8f, 0x05, 0x9e, 0x8e, 0xb8, 0x6a, 0xe4, 0xb5, 0x10, 0x27, 0xce,
+	0x1b, 0x1c, 0x2c, 0x17, 0xea, 0x66, 0x0b, 0x96, 0xeb, 0x30, 0x3e, 0xa4, 0x31, 0x3a, 0x8f, 0x30,
+	0x3e, 0x24, 0x06, 0x6e, 0xe1, 0x1d, 0x7f, 0x17, 0x56, 0x5a, 0xb2, 0xc1, 0x81, 0x28, 0xd0, 0xc3,
+	0x72, 0x19, 0xf8, 0xb1, 0xe1, 0x26, 0xb8, 0x98, 0x34, 0x07, 0xb3, 0x75, 0xf6, 0x1f, 0xe8, 0x69,
+	0xa2, 0x67, 0x35, 0x3d, 0x77, 0x07, 0x6b, 0xb9, 0x23, 0xc7, 0x91, 0x74, 0xa3, 0xe1, 0x4a, 0x3c,
+	0x42, 0xdc, 0xf7, 0x08, 0xf4, 0x1c, 0xd1, 0x1d, 0x1e, 0x01, 0x5f, 0x49, 0x12, 0xef, 0x12, 0xa6,
+	0x7d, 0x4b, 0xb5, 0xd4, 0x6d, 0x52, 0x28, 0x68, 0x56, 0x01, 0xeb, 0x55, 0x0f, 0x85, 0x06, 0x18,
+	0x6e, 0x19, 0xee, 0xe0, 0x0e, 0x9c, 0xcd, 0xaa, 0x96, 0x9a, 0xca, 0x54, 0x8f, 0x78, 0x94, 0xd3,
+	0x9e, 0x28, 0x5d, 0x16, 0xce, 0x64, 0x1b, 0xd6, 0xd2, 0x16, 0x7f, 0x0e, 0x5d, 0x62, 0xfc, 0x69,
+	0x69, 0x08, 0x3e, 0x30, 0xac, 0x12, 0x7f, 0xa0, 0xc2, 0x6d, 0xbc, 0x71, 0xe8, 0x1b, 0x30, 0x55,
+	0x75, 0x9b, 0x20, 0xc4, 0x4a, 0x96, 0x8a, 0x79, 0x6c, 0xff, 0x08, 0xaf, 0xa3, 0xa7, 0x02, 0x44,
+	0x83, 0xf4, 0xde, 0x34, 0x46, 0xa4, 0x40, 0x84, 0x5a, 0x32, 0x08, 0xb1, 0x52, 0x96, 0xed, 0x88,
+	0xfe, 0xa4, 0x6f, 0xc7, 0x40, 0x62, 0x28, 0xeb, 0x86, 0x20, 0xbd, 0x05, 0x8b, 0x5e, 0x70, 0x3b,
+	0x7a, 0x26, 0x5f, 0x32, 0x35, 0xa2, 0x1f, 0xd8, 0xa3, 0x57, 0x68, 0x78, 0x75, 0x97, 0xd4, 0xd9,
+	0x70, 0x49, 0x7f, 0x09, 0x75, 0x37, 0x1d, 0x68, 0xf9, 0x5f, 0x4f, 0x80, 0xfd, 0x7d, 0xaa, 0x2a,
+	0xd0, 0xc7, 0x73, 0x20, 0xd1, 0xeb, 0x48, 0xa1, 0x75, 0xe8, 0xa6, 0xd3, 0x27, 0x1f, 0x5d, 0xc6,
+	0xe4, 0xda, 0x74, 0x2a, 0xb3, 0xe9, 0x54, 0xa6, 0x81, 0xf0, 0x8f, 0x06, 0x13, 0x96, 0xe2, 0x30,
+	0xc2, 0x1e, 0xaf, 0x87, 0x7b, 0xb1, 0x6c, 0xd6, 0xc0, 0x66, 0xf5, 0x63, 0xb6, 0x02, 0x43, 0x65,
+	0x35, 0xaf, 0x65, 0x55, 0x8b, 0x18, 0x29, 0x95, 0x9d, 0xd1, 0x48, 0xfb, 0x12, 0x83, 0xd5, 0x03,
+	0xae, 0x23, 0x5d, 0x83, 0x51, 0x8f, 0x99, 0xda, 0x57, 0x17, 0x97, 0x0b, 0x2e, 0x0b, 0x80, 0xcb,
+	0x05, 0x47, 0x17, 0xf3, 0x9a, 0xab, 0xe9, 0xc6, 0xac, 0xc6, 0xe6, 0x6a, 0x07, 0x4a, 0xe0, 0x25,
+	0xa7, 0xf9, 0xf7, 0xdb, 0xcf, 0x0d, 0x87, 0x7a, 0x13, 0x7a, 0x0c, 0x9c, 0x21, 0x46, 0x96, 0xdf,
+	0xe8, 0xac, 0xe7, 0x46, 0xeb, 0xe3, 0xb3, 0x05, 0x9d, 0x71, 0x9c, 0xa9, 0x49, 0xbb, 0xbc, 0xed,
+	0x6a, 0x62, 0x77, 0x34, 0xd3, 0x22, 0x46, 0xe5, 0x44, 0x49, 0xcd, 0x78, 0x12, 0x53, 0xb5, 0xc6,
+	0x01, 0xc7, 0xe0, 0x34, 0xf3, 0xec, 0x4c, 0x0d, 0x2d, 0x23, 0x76, 0xf4, 0x96, 0xcb, 0x70, 0xd6,
+	0x35, 0x0f, 0xa3, 0x19, 0x98, 0x8c, 0x25, 0x93, 0xf1, 0xfb, 0xc9, 0x58, 0x72, 0xe7, 0xee, 0x7e,
+	0x2a, 0xf9, 0xf6, 0x41, 0x3c, 0xf5, 0x60, 0xff, 0xfe, 0x41, 0x7c, 0x7b, 0xe7, 0xf6, 0x4e, 0xfc,
+	0xd6, 0x60, 0x07, 0x9a, 0x80, 0x51, 0x8f, 0xc4, 0xc3, 0xd8, 0xee, 0xfd, 0x78, 0x72, 0x50, 0x40,
+	0xf3, 0x30, 0xe3, 0x39, 0xbc, 0x15, 0x4b, 0xc6, 0x52, 0xdb, 0x77, 0xf7, 0xf6, 0x76, 0x92, 0x7b,
+	0xf1, 0xfd, 0xe4, 0x60, 0xe7, 0xda, 0xcb, 0x11, 0xe8, 0xa6, 0xd1, 0xa1, 0xf7, 0xa0, 0x87, 0x71,
+	0x1b, 0xe4, 0x9d, 0x79, 0xbc, 0x04, 0x4a, 0x9c, 0x0f, 0x17, 0x62, 0x99, 0x91, 0x46, 0x3e, 0xfa,
+	0xe5, 0xcf, 0x4f, 0x3a, 0x07, 0xd1, 0x19, 0x87, 0x6f, 0x32, 0xc2, 0x84, 0x7e, 0x14, 0x60, 0x3c,
+	0x90, 0xed, 0xa0, 0x4d, 0x7f, 0xdb, 0xcd, 0xd8, 0x95, 0x78, 0xa5, 0x6d, 0x3d, 0x0e, 0xf3, 0x22,
+	0x85, 0xb9, 0x88, 0xce, 0x3b, 0x30, 0xeb, 0x27, 0x7b, 0xc5, 0x60, 0x4a, 0xa6, 0xf2, 0x88, 0xbe,
+	0x5f, 0x8f, 0xd1, 0xd7, 0x02, 0x8c, 0xf8, 0xcf, 0x32, 0xe8, 0xb2, 0x3f, 0x84, 0xd0, 0xf9, 0x48,
+	0x5c, 0x6f, 0x4f, 0x89, 0x83, 0xbe, 0x40, 0x41, 0xcf, 0xa1, 0x59, 0x5f, 0xd0, 0x14, 0xaa, 0x92,
+	0xa7, 0x26, 0xd0, 0xf7, 0x02, 0x8c, 0x05, 0xcd, 0x45, 0x68, 0xc3, 0xdf, 0x7b, 0x93, 0x79, 0x4b,
+	0xdc, 0x6c, 0x57, 0x8d, 0xc3, 0x5e, 0xa1, 0xb0, 0xcf, 0xa3, 0xb9, 0x10, 0xd8, 0x98, 0x1b, 0x41,
+	0x1f, 0x0b, 0x30, 0x50, 0x4f, 0xe0, 0xd0, 0x85, 0xa6, 0x57, 0x5c, 0xad, 0xd0, 0xe5, 0x56, 0x44,
+	0x39, 0xa8, 0x49, 0x0a, 0x6a, 0x04, 0x45, 0xfc, 0x40, 0xa1, 0x9f, 0x05, 0x98, 0x0c, 0xa3, 0x36,
+	0xe8, 0x6a, 0x53, 0x57, 0x41, 0x04, 0x4e, 0xbc, 0x76, 0x12, 0xd5, 0x96, 0xca, 0x96, 0xa5, 0xd2,
+	0xb0, 0x15, 0xe9, 0xdf, 0x57, 0xd0, 0x97, 0x02, 0x0c, 0xfb, 0xb0, 0x1f, 0x74, 0xa9, 0x29, 0x04,
+	0x17, 0xc3, 0x12, 0x57, 0xdb, 0xd0, 0x68, 0x09, 0x2b, 0xef, 0x2c, 0xc5, 0xa1, 0x50, 0xe8, 0x5b,
+	0x01, 0x46, 0x03, 0x08, 0x10, 0x5a, 0x6f, 0xd5, 0x7b, 0x3d, 0xdb, 0x12, 0x37, 0xda, 0xd4, 0xe2,
+	0xb8, 0x97, 0x29, 0xee, 0x79, 0x24, 0x85, 0xe2, 0x66, 0xdc, 0xeb, 0xb9, 0x00, 0xd1, 0xf0, 0xd9,
+	0x1f, 0x5d, 0x0f, 0x6b, 0xf5, 0x26, 0xac, 0x43, 0xbc, 0x71, 0x32, 0xe5, 0xa0, 0x1b, 0x60, 0xac,
+	0xc2, 0x79, 0xde, 0x94, 0x34, 0xd5, 0xa9, 0x3e, 0x72, 0x4f, 0x05, 0x38, 0xe7, 0xcb, 0x21, 0xd0,
+	0x5a, 0x18, 0x0c, 0x7f, 0x56, 0x22, 0x5e, 0x6e, 0x4b, 0x87, 0x23, 0x1e, 0xa7, 0x88, 0x87, 0xd1,
+	0x90, 0x83, 0xb8, 0xe4, 0x08, 0xa2, 0x9f, 0x04, 0x98, 0x0c, 0x1b, 0xe6, 0x83, 0x5a, 0xb2, 0x05,
+	0x12, 0x11, 0xd4, 0x92, 0xad, 0x70, 0x07, 0xe9, 0x3f, 0x14, 0xf2, 0x02, 0x9a, 0x77, 0x20, 0xbb,
+	0x86, 0x54, 0xde, 0x8f, 0x6c, 0x18, 0x42, 0x5f, 0x08, 0x10, 0xf1, 0x63, 0x51, 0x68, 0x35, 0x2c,
+	0x5d, 0xbe, 0xac, 0x4c, 0x5c, 0x6b, 0x47, 0x85, 0xa3, 0x5d, 0xa0, 0x68, 0x67, 0x50, 0x34, 0x08,
+	0x2d, 0xff, 0x7e, 0x7c, 0x26, 0xc0, 0x90, 0x87, 0x8b, 0x20, 0x39, 0x38, 0x4f, 0x7e, 0x64, 0x47,
+	0x54, 0x5a, 0x96, 0x6f, 0x35, 0x99, 0x4e, 0xfb, 0xd9, 0x93, 0xb8, 0xdd, 0x7d, 0x13, 0x21, 0xcc,
+	0x01, 0xfd, 0xb7, 0x05, 0xf7, 0xbe, 0x34, 0x46, 0xbc, 0x7a, 0x02, 0x4d, 0x1e, 0xc2, 0x26, 0x0d,
+	0xe1, 0x12, 0x92, 0x9b, 0x85, 0x40, 0x79, 0x80, 0xf2, 0x88, 0x15, 0xc6, 0x63, 0xf4, 0x21, 0x40,
+	0x6d, 0x64, 0x44, 0x8b, 0x01, 0xdf, 0x5a, 0x37, 0x5b, 0x10, 0x97, 0x9a, 0x0b, 0x72, 0x60, 0x13,
+	0x14, 0xd8, 0x39, 0x34, 0xec, 0x00, 0xab, 0x63, 0x07, 0xe8, 0x73, 0x01, 0x90, 0x77, 0x40, 0x47,
+	0x4a, 0x33, 0xeb, 0x2e, 0xc6, 0x20, 0x5e, 0x6a, 0x5d, 0x21, 0x68, 0x3a, 0xa8, 0x83, 0xc5, 0xbb,
+	0xa6, 0x96, 0xa4, 0x4f, 0x05, 0x18, 0xf2, 0x4c, 0xe5, 0x41, 0x65, 0x19, 0x44, 0x06, 0x44, 0xa5,
+	0x65, 0x79, 0x8e, 0x71, 0x8e, 0x62, 0x9c, 0x42, 0x13, 0xbe, 0x18, 0x99, 0xf0, 0xd6, 0xc1, 0xb3,
+	0x57, 0x51, 0xe1, 0xc5, 0xab, 0xa8, 0xf0, 0xc7, 0xab, 0xa8, 0xf0, 0xe4, 0x75, 0xb4, 0xe3, 0xc5,
+	0xeb, 0x68, 0xc7, 0xaf, 0xaf, 0xa3, 0x1d, 0xef, 0x6c, 0xe6, 0x34, 0xeb, 0xa8, 0x94, 0x96, 0x33,
+	0xa4, 0xa0, 0x38, 0x9e, 0x89, 0x91, 0xab, 0xfe, 0xbe, 0xa8, 0x16, 0x8b, 0xca, 0x07, 0x4a, 0x3a,
+	0x4f, 0xd2, 0xa6, 0x65, 0x60, 0xb5, 0xc0, 0xfe, 0x49, 0x93, 0xee, 0xa1, 0x7f, 0x8b, 0xbd, 0xfc,
+	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x77, 0x18, 0xfc, 0x27, 0x11, 0x1a, 0x00, 0x00,
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the current parameters for the blobstream module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error)
+	// Attestations queries the attestations with nonces in the provided range,
+	// optionally filtered by type, in pages.
+	Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	// AttestationNonceRangeForTime queries the nonces of the earliest and latest
+	// attestations created in the provided time range.
+	AttestationNonceRangeForTime(ctx context.Context, in *QueryAttestationNonceRangeForTimeRequest, opts ...grpc.CallOption) (*QueryAttestationNonceRangeForTimeResponse, error)
+	// AttestationConfirms queries the verified signatures submitted for the
+	// attestation with the provided nonce.
+	AttestationConfirms(ctx context.Context, in *QueryAttestationConfirmsRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmsResponse, error)
+	// AttestationConfirmPower queries the power accumulated by the signatures
+	// submitted for the attestation with the provided nonce, along with the
+	// power threshold of the validator set it is checked against.
+	AttestationConfirmPower(ctx context.Context, in *QueryAttestationConfirmPowerRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmPowerResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootTupleRoot returns the data root tuple root of the data commitment
+	// with the provided nonce. It is the root of the Merkle tree whose leaves
+	// are the (height, data root) tuples of the blocks in the data commitment
+	// range, and is the value signed by orchestrators.
+	DataRootTupleRoot(ctx context.Context, in *QueryDataRootTupleRootRequest, opts ...grpc.CallOption) (*QueryDataRootTupleRootResponse, error)
+	// DataRootTupleInclusionProof returns a Merkle proof of the inclusion of the
+	// (height, data root) tuple of the provided height in the data root tuple
+	// root of the data commitment with the provided nonce.
+	DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error)
+	// EVMAddress returns the evm address associated with a supplied
+	// validator address
+	EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error)
+	// EVMAddressAtHeight returns the evm address that was associated with a
+	// supplied validator address at the provided height
+	EVMAddressAtHeight(ctx context.Context, in *QueryEVMAddressAtHeightRequest, opts ...grpc.CallOption) (*QueryEVMAddressAtHeightResponse, error)
+	// EVMAddressHistory returns the history of the evm addresses associated
+	// with a supplied validator address
+	EVMAddressHistory(ctx context.Context, in *QueryEVMAddressHistoryRequest, opts ...grpc.CallOption) (*QueryEVMAddressHistoryResponse, error)
 }
 
-func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttestationRequestByNonce(ctx context.Context, in *QueryAttestationRequestByNonceRequest, opts ...grpc.CallOption) (*QueryAttestationRequestByNonceResponse, error) {
+	out := new(QueryAttestationRequestByNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationRequestByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		{
-			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestAttestationNonce(ctx context.Context, in *QueryLatestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryLatestAttestationNonceResponse, error) {
+	out := new(QueryLatestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestAttestationNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EarliestAttestationNonce(ctx context.Context, in *QueryEarliestAttestationNonceRequest, opts ...grpc.CallOption) (*QueryEarliestAttestationNonceResponse, error) {
+	out := new(QueryEarliestAttestationNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EarliestAttestationNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEVMAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Attestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/Attestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEVMAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AttestationNonceRangeForTime(ctx context.Context, in *QueryAttestationNonceRangeForTimeRequest, opts ...grpc.CallOption) (*QueryAttestationNonceRangeForTimeResponse, error) {
+	out := new(QueryAttestationNonceRangeForTimeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationNonceRangeForTime", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttestationConfirms(ctx context.Context, in *QueryAttestationConfirmsRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmsResponse, error) {
+	out := new(QueryAttestationConfirmsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationConfirms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AttestationConfirmPower(ctx context.Context, in *QueryAttestationConfirmPowerRequest, opts ...grpc.CallOption) (*QueryAttestationConfirmPowerResponse, error) {
+	out := new(QueryAttestationConfirmPowerResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/AttestationConfirmPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmAddress) > 0 {
-		i -= len(m.EvmAddress)
-		copy(dAtA[i:], m.EvmAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LatestValsetRequestBeforeNonce(ctx context.Context, in *QueryLatestValsetRequestBeforeNonceRequest, opts ...grpc.CallOption) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	out := new(QueryLatestValsetRequestBeforeNonceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) LatestUnbondingHeight(ctx context.Context, in *QueryLatestUnbondingHeightRequest, opts ...grpc.CallOption) (*QueryLatestUnbondingHeightResponse, error) {
+	out := new(QueryLatestUnbondingHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestUnbondingHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) DataCommitmentRangeForHeight(ctx context.Context, in *QueryDataCommitmentRangeForHeightRequest, opts ...grpc.CallOption) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	out := new(QueryDataCommitmentRangeForHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) LatestDataCommitment(ctx context.Context, in *QueryLatestDataCommitmentRequest, opts ...grpc.CallOption) (*QueryLatestDataCommitmentResponse, error) {
+	out := new(QueryLatestDataCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/LatestDataCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (c *queryClient) DataRootTupleRoot(ctx context.Context, in *QueryDataRootTupleRootRequest, opts ...grpc.CallOption) (*QueryDataRootTupleRootResponse, error) {
+	out := new(QueryDataRootTupleRootResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataRootTupleRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Attestation != nil {
-		l = m.Attestation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DataRootTupleInclusionProof(ctx context.Context, in *QueryDataRootTupleInclusionProofRequest, opts ...grpc.CallOption) (*QueryDataRootTupleInclusionProofResponse, error) {
+	out := new(QueryDataRootTupleInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/DataRootTupleInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) EVMAddress(ctx context.Context, in *QueryEVMAddressRequest, opts ...grpc.CallOption) (*QueryEVMAddressResponse, error) {
+	out := new(QueryEVMAddressResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) EVMAddressAtHeight(ctx context.Context, in *QueryEVMAddressAtHeightRequest, opts ...grpc.CallOption) (*QueryEVMAddressAtHeightResponse, error) {
+	out := new(QueryEVMAddressAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddressAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	return out, nil
+}
+
+func (c *queryClient) EVMAddressHistory(ctx context.Context, in *QueryEVMAddressHistoryRequest, opts ...grpc.CallOption) (*QueryEVMAddressHistoryResponse, error) {
+	out := new(QueryEVMAddressHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Query/EVMAddressHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the current parameters for the blobstream module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AttestationRequestByNonce queries attestation request by nonce.
+	// Returns nil if not found.
+	AttestationRequestByNonce(context.Context, *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error)
+	// LatestAttestationNonce queries latest attestation nonce.
+	LatestAttestationNonce(context.Context, *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error)
+	// EarliestAttestationNonce queries the earliest attestation nonce.
+	EarliestAttestationNonce(context.Context, *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error)
+	// Attestations queries the attestations with nonces in the provided range,
+	// optionally filtered by type, in pages.
+	Attestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	// AttestationNonceRangeForTime queries the nonces of the earliest and latest
+	// attestations created in the provided time range.
+	AttestationNonceRangeForTime(context.Context, *QueryAttestationNonceRangeForTimeRequest) (*QueryAttestationNonceRangeForTimeResponse, error)
+	// AttestationConfirms queries the verified signatures submitted for the
+	// attestation with the provided nonce.
+	AttestationConfirms(context.Context, *QueryAttestationConfirmsRequest) (*QueryAttestationConfirmsResponse, error)
+	// AttestationConfirmPower queries the power accumulated by the signatures
+	// submitted for the attestation with the provided nonce, along with the
+	// power threshold of the validator set it is checked against.
+	AttestationConfirmPower(context.Context, *QueryAttestationConfirmPowerRequest) (*QueryAttestationConfirmPowerResponse, error)
+	// LatestValsetRequestBeforeNonce Queries latest Valset request before nonce.
+	// And, even if the current nonce is a valset, it will return the previous
+	// one.
+	// If the provided nonce is 1, it will return an error, because, there is
+	// no valset before nonce 1.
+	LatestValsetRequestBeforeNonce(context.Context, *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error)
+	// LatestUnbondingHeight returns the latest unbonding height
+	LatestUnbondingHeight(context.Context, *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error)
+	// DataCommitmentRangeForHeight returns the data commitment window
+	// that includes the provided height
+	DataCommitmentRangeForHeight(context.Context, *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error)
+	// LatestDataCommitment returns the latest data commitment in store
+	LatestDataCommitment(context.Context, *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error)
+	// DataRootTupleRoot returns the data root tuple root of the data commitment
+	// with the provided nonce. It is the root of the Merkle tree whose leaves
+	// are the (height, data root) tuples of the blocks in the data commitment
+	// range, and is the value signed by orchestrators.
+	DataRootTupleRoot(context.Context, *QueryDataRootTupleRootRequest) (*QueryDataRootTupleRootResponse, error)
+	// DataRootTupleInclusionProof returns a Merkle proof of the inclusion of the
+	// (height, data root) tuple of the provided height in the data root tuple
+	// root of the data commitment with the provided nonce.
+	DataRootTupleInclusionProof(context.Context, *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error)
+	// EVMAddress returns the evm address associated with a supplied
+	// validator address
+	EVMAddress(context.Context, *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error)
+	// EVMAddressAtHeight returns the evm address that was associated with a
+	// supplied validator address at the provided height
+	EVMAddressAtHeight(context.Context, *QueryEVMAddressAtHeightRequest) (*QueryEVMAddressAtHeightResponse, error)
+	// EVMAddressHistory returns the history of the evm addresses associated
+	// with a supplied validator address
+	EVMAddressHistory(context.Context, *QueryEVMAddressHistoryRequest) (*QueryEVMAddressHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AttestationRequestByNonce(ctx context.Context, req *QueryAttestationRequestByNonceRequest) (*QueryAttestationRequestByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationRequestByNonce not implemented")
+}
+func (*UnimplementedQueryServer) LatestAttestationNonce(ctx context.Context, req *QueryLatestAttestationNonceRequest) (*QueryLatestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestAttestationNonce not implemented")
+}
+func (*UnimplementedQueryServer) EarliestAttestationNonce(ctx context.Context, req *QueryEarliestAttestationNonceRequest) (*QueryEarliestAttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarliestAttestationNonce not implemented")
+}
+func (*UnimplementedQueryServer) Attestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestations not implemented")
+}
+func (*UnimplementedQueryServer) AttestationNonceRangeForTime(ctx context.Context, req *QueryAttestationNonceRangeForTimeRequest) (*QueryAttestationNonceRangeForTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationNonceRangeForTime not implemented")
+}
+func (*UnimplementedQueryServer) AttestationConfirms(ctx context.Context, req *QueryAttestationConfirmsRequest) (*QueryAttestationConfirmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationConfirms not implemented")
+}
+func (*UnimplementedQueryServer) AttestationConfirmPower(ctx context.Context, req *QueryAttestationConfirmPowerRequest) (*QueryAttestationConfirmPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationConfirmPower not implemented")
+}
+func (*UnimplementedQueryServer) LatestValsetRequestBeforeNonce(ctx context.Context, req *QueryLatestValsetRequestBeforeNonceRequest) (*QueryLatestValsetRequestBeforeNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestValsetRequestBeforeNonce not implemented")
+}
+func (*UnimplementedQueryServer) LatestUnbondingHeight(ctx context.Context, req *QueryLatestUnbondingHeightRequest) (*QueryLatestUnbondingHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestUnbondingHeight not implemented")
+}
+func (*UnimplementedQueryServer) DataCommitmentRangeForHeight(ctx context.Context, req *QueryDataCommitmentRangeForHeightRequest) (*QueryDataCommitmentRangeForHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataCommitmentRangeForHeight not implemented")
+}
+func (*UnimplementedQueryServer) LatestDataCommitment(ctx context.Context, req *QueryLatestDataCommitmentRequest) (*QueryLatestDataCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestDataCommitment not implemented")
+}
+func (*UnimplementedQueryServer) DataRootTupleRoot(ctx context.Context, req *QueryDataRootTupleRootRequest) (*QueryDataRootTupleRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootTupleRoot not implemented")
+}
+func (*UnimplementedQueryServer) DataRootTupleInclusionProof(ctx context.Context, req *QueryDataRootTupleInclusionProofRequest) (*QueryDataRootTupleInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootTupleInclusionProof not implemented")
+}
+func (*UnimplementedQueryServer) EVMAddress(ctx context.Context, req *QueryEVMAddressRequest) (*QueryEVMAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMAddress not implemented")
+}
+func (*UnimplementedQueryServer) EVMAddressAtHeight(ctx context.Context, req *QueryEVMAddressAtHeightRequest) (*QueryEVMAddressAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMAddressAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) EVMAddressHistory(ctx context.Context, req *QueryEVMAddressHistoryRequest) (*QueryEVMAddressHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EVMAddressHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationRequestByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationRequestByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationRequestByNonce(ctx, req.(*QueryAttestationRequestByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LatestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestAttestationNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestAttestationNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestAttestationNonce(ctx, req.(*QueryLatestAttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EarliestAttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEarliestAttestationNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EarliestAttestationNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EarliestAttestationNonce(ctx, req.(*QueryEarliestAttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Attestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Attestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/Attestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationNonceRangeForTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationNonceRangeForTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationNonceRangeForTime(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationNonceRangeForTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationNonceRangeForTime(ctx, req.(*QueryAttestationNonceRangeForTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationConfirmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationConfirms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationConfirms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationConfirms(ctx, req.(*QueryAttestationConfirmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AttestationConfirmPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationConfirmPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationConfirmPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/AttestationConfirmPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationConfirmPower(ctx, req.(*QueryAttestationConfirmPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestValsetRequestBeforeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestValsetRequestBeforeNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestValsetRequestBeforeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestValsetRequestBeforeNonce(ctx, req.(*QueryLatestValsetRequestBeforeNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestUnbondingHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestUnbondingHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestUnbondingHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestUnbondingHeight(ctx, req.(*QueryLatestUnbondingHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataCommitmentRangeForHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataCommitmentRangeForHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataCommitmentRangeForHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataCommitmentRangeForHeight(ctx, req.(*QueryDataCommitmentRangeForHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestDataCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLatestDataCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestDataCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/LatestDataCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestDataCommitment(ctx, req.(*QueryLatestDataCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataRootTupleRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootTupleRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataRootTupleRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataRootTupleRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataRootTupleRoot(ctx, req.(*QueryDataRootTupleRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DataRootTupleInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDataRootTupleInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DataRootTupleInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/DataRootTupleInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DataRootTupleInclusionProof(ctx, req.(*QueryDataRootTupleInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EVMAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEVMAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EVMAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EVMAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EVMAddress(ctx, req.(*QueryEVMAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EVMAddressAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEVMAddressAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EVMAddressAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EVMAddressAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EVMAddressAtHeight(ctx, req.(*QueryEVMAddressAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EVMAddressHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEVMAddressHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EVMAddressHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Query/EVMAddressHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EVMAddressHistory(ctx, req.(*QueryEVMAddressHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.qgb.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AttestationRequestByNonce",
+			Handler:    _Query_AttestationRequestByNonce_Handler,
+		},
+		{
+			MethodName: "LatestAttestationNonce",
+			Handler:    _Query_LatestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "EarliestAttestationNonce",
+			Handler:    _Query_EarliestAttestationNonce_Handler,
+		},
+		{
+			MethodName: "Attestations",
+			Handler:    _Query_Attestations_Handler,
+		},
+		{
+			MethodName: "AttestationNonceRangeForTime",
+			Handler:    _Query_AttestationNonceRangeForTime_Handler,
+		},
+		{
+			MethodName: "AttestationConfirms",
+			Handler:    _Query_AttestationConfirms_Handler,
+		},
+		{
+			MethodName: "AttestationConfirmPower",
+			Handler:    _Query_AttestationConfirmPower_Handler,
+		},
+		{
+			MethodName: "LatestValsetRequestBeforeNonce",
+			Handler:    _Query_LatestValsetRequestBeforeNonce_Handler,
+		},
+		{
+			MethodName: "LatestUnbondingHeight",
+			Handler:    _Query_LatestUnbondingHeight_Handler,
+		},
+		{
+			MethodName: "DataCommitmentRangeForHeight",
+			Handler:    _Query_DataCommitmentRangeForHeight_Handler,
+		},
+		{
+			MethodName: "LatestDataCommitment",
+			Handler:    _Query_LatestDataCommitment_Handler,
+		},
+		{
+			MethodName: "DataRootTupleRoot",
+			Handler:    _Query_DataRootTupleRoot_Handler,
+		},
+		{
+			MethodName: "DataRootTupleInclusionProof",
+			Handler:    _Query_DataRootTupleInclusionProof_Handler,
+		},
+		{
+			MethodName: "EVMAddress",
+			Handler:    _Query_EVMAddress_Handler,
+		},
+		{
+			MethodName: "EVMAddressAtHeight",
+			Handler:    _Query_EVMAddressAtHeight_Handler,
+		},
+		{
+			MethodName: "EVMAddressHistory",
+			Handler:    _Query_EVMAddressHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/qgb/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationNonceRangeForTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationNonceRangeForTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationNonceRangeForTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationNonceRangeForTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationNonceRangeForTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationNonceRangeForTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BeginNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationConfirmsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationConfirmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationConfirmPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationConfirmPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationConfirmPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationConfirmPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationConfirmPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValsetNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ThresholdReached {
+		i--
+		if m.ThresholdReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEarliestAttestationNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestUnbondingHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLatestDataCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DataCommitment != nil {
+		{
+			size, err := m.DataCommitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEVMAddressHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEVMAddressHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEVMAddressHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BeginNonce))
+	}
+	if m.EndNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EndNonce))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationNonceRangeForTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationNonceRangeForTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BeginNonce))
+	}
+	if m.EndNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EndNonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationConfirmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationConfirmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestationConfirmPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationConfirmPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.ThresholdReached {
+		n += 2
+	}
+	if m.ValsetNonce != 0 {
+		n += 1 + sovQuery(uint64(m.ValsetNonce))
+	}
+	return n
+}
+
+func (m *QueryLatestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEarliestAttestationNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestUnbondingHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryLatestDataCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLatestDataCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryDataRootTupleInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataCommitment != nil {
+		l = m.DataCommitment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEVMAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEVMAddressHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &types.Any{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginNonce", wireType)
+			}
+			m.BeginNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndNonce", wireType)
+			}
+			m.EndNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AttestationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &types.Any{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationNonceRangeForTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationNonceRangeForTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationNonceRangeForTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationNonceRangeForTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationNonceRangeForTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationNonceRangeForTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginNonce", wireType)
+			}
+			m.BeginNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndNonce", wireType)
+			}
+			m.EndNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, AttestationConfirm{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationConfirmPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationConfirmPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationConfirmPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ThresholdReached = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryDataCommitmentRangeForHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDataCommitmentRangeForHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DataCommitment != nil {
-		l = m.DataCommitment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEVMAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryEVMAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2064,16 +5577,35 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2095,7 +5627,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,15 +5650,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2153,7 +5685,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2178,7 +5713,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2201,31 +5736,12 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2247,7 +5763,7 @@ func (m *QueryAttestationRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,17 +5786,17 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2290,28 +5806,11 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attestation == nil {
-				m.Attestation = &types.Any{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2333,7 +5832,7 @@ func (m *QueryAttestationRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2356,10 +5855,10 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2383,7 +5882,7 @@ func (m *QueryLatestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2406,17 +5905,17 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2426,11 +5925,28 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2452,7 +5968,7 @@ func (m *QueryLatestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,12 +5991,31 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2502,7 +6037,7 @@ func (m *QueryEarliestAttestationNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2525,17 +6060,17 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEarliestAttestationNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2545,11 +6080,28 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2571,7 +6123,7 @@ func (m *QueryEarliestAttestationNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleRootRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2594,10 +6146,10 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataRootTupleRootRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataRootTupleRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2640,7 +6192,7 @@ func (m *QueryLatestValsetRequestBeforeNonceRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,15 +6215,15 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataRootTupleRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestValsetRequestBeforeNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataRootTupleRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,13 +6250,47 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
 			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2726,7 +6312,7 @@ func (m *QueryLatestValsetRequestBeforeNonceResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleInclusionProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,16 +6331,54 @@ func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2776,7 +6400,7 @@ func (m *QueryLatestUnbondingHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDataRootTupleInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2799,17 +6423,17 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestUnbondingHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDataRootTupleInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2819,11 +6443,129 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataCommitment == nil {
+				m.DataCommitment = &DataCommitment{}
+			}
+			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2845,7 +6587,7 @@ func (m *QueryLatestUnbondingHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,12 +6610,44 @@ func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2895,7 +6669,7 @@ func (m *QueryLatestDataCommitmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2918,17 +6692,17 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLatestDataCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2938,27 +6712,23 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataCommitment == nil {
-				m.DataCommitment = &DataCommitment{}
-			}
-			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2981,7 +6751,7 @@ func (m *QueryLatestDataCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,13 +6774,45 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -3050,7 +6852,7 @@ func (m *QueryDataCommitmentRangeForHeightRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3073,15 +6875,15 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDataCommitmentRangeForHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataCommitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3108,10 +6910,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DataCommitment == nil {
-				m.DataCommitment = &DataCommitment{}
-			}
-			if err := m.DataCommitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3136,7 +6935,7 @@ func (m *QueryDataCommitmentRangeForHeightResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3159,10 +6958,10 @@ func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3218,7 +7017,7 @@ func (m *QueryEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEVMAddressHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3241,17 +7040,17 @@ func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEVMAddressHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEVMAddressHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3261,23 +7060,25 @@ func (m *QueryEVMAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, EVMAddressRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// EVMSignatureLength is the length of an [R || S || V] ECDSA signature.
+const EVMSignatureLength = crypto.SignatureLength
+
+// RecoverEVMAddress returns the EVM address that produced the provided
+// signature over the digest of an attestation. Similar to the Blobstream
+// contract, the signature is expected to be over the EIP-191 signed message
+// hash of the digest, and both the 0/1 and 27/28 recovery identifiers are
+// accepted. Malleable signatures, i.e. with an S value in the upper half of
+// the curve order, are rejected.
+func RecoverEVMAddress(digest ethcmn.Hash, signature []byte) (ethcmn.Address, error) {
+	if len(signature) != EVMSignatureLength {
+		return ethcmn.Address{}, errors.Wrapf(ErrInvalidAttestationSignature, "signature has size %d, expected %d", len(signature), EVMSignatureLength)
+	}
+	sig := make([]byte, EVMSignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:64])
+	if !crypto.ValidateSignatureValues(sig[crypto.RecoveryIDOffset], r, s, true) {
+		return ethcmn.Address{}, errors.Wrap(ErrInvalidAttestationSignature, "invalid signature values")
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(digest.Bytes()), sig)
+	if err != nil {
+		return ethcmn.Address{}, errors.Wrap(ErrInvalidAttestationSignature, err.Error())
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverEVMAddress(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	expected := crypto.PubkeyToAddress(key.PublicKey)
+	digest := crypto.Keccak256Hash([]byte("digest"))
+
+	signature, err := crypto.Sign(accounts.TextHash(digest.Bytes()), key)
+	require.NoError(t, err)
+
+	got, err := types.RecoverEVMAddress(digest, signature)
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	// the recovery identifier can also be offset by 27 as done by most EVM
+	// tooling
+	offset := append([]byte{}, signature...)
+	offset[crypto.RecoveryIDOffset] += 27
+	got, err = types.RecoverEVMAddress(digest, offset)
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	// a signature over the raw digest recovers a different address
+	raw, err := crypto.Sign(digest.Bytes(), key)
+	require.NoError(t, err)
+	got, err = types.RecoverEVMAddress(digest, raw)
+	require.NoError(t, err)
+	assert.NotEqual(t, expected, got)
+
+	// the malleable counterpart of the signature is rejected
+	secp256k1N := crypto.S256().Params().N
+	malleable := append([]byte{}, signature...)
+	s := new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(signature[32:64]))
+	s.FillBytes(malleable[32:64])
+	malleable[crypto.RecoveryIDOffset] ^= 1
+	_, err = types.RecoverEVMAddress(digest, malleable)
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+
+	_, err = types.RecoverEVMAddress(digest, signature[:64])
+	assert.ErrorIs(t, err, types.ErrInvalidAttestationSignature)
+}
+
+func TestDataCommitmentSignBytes(t *testing.T) {
+	root := crypto.Keccak256([]byte("root"))
+
+	// the digest is the hash of the abi encoded domain separator, nonce and
+	// data root tuple root
+	encoded := append([]byte{}, types.DcDomainSeparator.Bytes()...)
+	encoded = append(encoded, ethcmn.LeftPadBytes(big.NewInt(10).Bytes(), 32)...)
+	encoded = append(encoded, root...)
+
+	digest, err := types.DataCommitmentSignBytes(10, root)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(encoded), digest)
+
+	_, err = types.DataCommitmentSignBytes(10, root[:31])
+	assert.Error(t, err)
+}
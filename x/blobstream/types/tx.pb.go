@@ -122,36 +122,148 @@ func (m *MsgRegisterEVMAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterEVMAddressResponse proto.InternalMessageInfo
 
+// MsgSubmitAttestationSignature submits the signature of a validator over the
+// digest of an attestation.
+type MsgSubmitAttestationSignature struct {
+	// The operating address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// The nonce of the signed attestation.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The 65 bytes [R || S || V] ECDSA signature over the EIP-191 signed message
+	// hash of the attestation digest, as verified by the Blobstream contract.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgSubmitAttestationSignature) Reset()         { *m = MsgSubmitAttestationSignature{} }
+func (m *MsgSubmitAttestationSignature) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationSignature) ProtoMessage()    {}
+func (*MsgSubmitAttestationSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85ed1095628e2204, []int{2}
+}
+func (m *MsgSubmitAttestationSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestationSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAttestationSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationSignature.Merge(m, src)
+}
+func (m *MsgSubmitAttestationSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestationSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAttestationSignature proto.InternalMessageInfo
+
+func (m *MsgSubmitAttestationSignature) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSubmitAttestationSignature) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MsgSubmitAttestationSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgSubmitAttestationSignatureResponse is the response to submitting an
+// attestation signature.
+type MsgSubmitAttestationSignatureResponse struct {
+}
+
+func (m *MsgSubmitAttestationSignatureResponse) Reset()         { *m = MsgSubmitAttestationSignatureResponse{} }
+func (m *MsgSubmitAttestationSignatureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestationSignatureResponse) ProtoMessage()    {}
+func (*MsgSubmitAttestationSignatureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85ed1095628e2204, []int{3}
+}
+func (m *MsgSubmitAttestationSignatureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitAttestationSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestationSignatureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitAttestationSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestationSignatureResponse.Merge(m, src)
+}
+func (m *MsgSubmitAttestationSignatureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestationSignatureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestationSignatureResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitAttestationSignatureResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterEVMAddress)(nil), "celestia.qgb.v1.MsgRegisterEVMAddress")
 	proto.RegisterType((*MsgRegisterEVMAddressResponse)(nil), "celestia.qgb.v1.MsgRegisterEVMAddressResponse")
+	proto.RegisterType((*MsgSubmitAttestationSignature)(nil), "celestia.qgb.v1.MsgSubmitAttestationSignature")
+	proto.RegisterType((*MsgSubmitAttestationSignatureResponse)(nil), "celestia.qgb.v1.MsgSubmitAttestationSignatureResponse")
 }
 
 func init() { proto.RegisterFile("celestia/qgb/v1/tx.proto", fileDescriptor_85ed1095628e2204) }
 
 var fileDescriptor_85ed1095628e2204 = []byte{
-	// 335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0x86, 0x9b, 0x0a, 0x82, 0xf1, 0xa0, 0x2e, 0x15, 0x6a, 0xa9, 0xa9, 0x14, 0x11, 0x2f, 0x4d,
-	0xa8, 0x82, 0x77, 0x0b, 0x3d, 0x16, 0x64, 0x05, 0x0f, 0x5e, 0x4a, 0xb6, 0x0d, 0x31, 0xb0, 0xbb,
-	0xb3, 0xcd, 0xc4, 0xa5, 0x9e, 0x04, 0x9f, 0x40, 0xf4, 0xe6, 0x73, 0xf8, 0x10, 0x1e, 0x8b, 0x5e,
-	0x3c, 0x4a, 0xeb, 0x83, 0x48, 0xbb, 0xbb, 0x45, 0xa4, 0x07, 0x6f, 0x93, 0xfc, 0x5f, 0xfe, 0x7f,
-	0x32, 0x43, 0xab, 0x03, 0x15, 0x2a, 0x74, 0x46, 0x8a, 0x91, 0x0e, 0x44, 0xda, 0x16, 0x6e, 0xcc,
-	0x13, 0x0b, 0x0e, 0xbc, 0xad, 0x42, 0xe1, 0x23, 0x1d, 0xf0, 0xb4, 0x5d, 0xab, 0x68, 0xd0, 0xb0,
-	0xd0, 0xc4, 0xbc, 0xca, 0xb0, 0xda, 0xde, 0x00, 0x30, 0x02, 0xec, 0x67, 0x42, 0x76, 0xc8, 0xa5,
-	0xba, 0x06, 0xd0, 0xa1, 0x12, 0x32, 0x31, 0x42, 0xc6, 0x31, 0x38, 0xe9, 0x0c, 0xc4, 0xb9, 0xda,
-	0xbc, 0xa7, 0xbb, 0x3d, 0xd4, 0xbe, 0xd2, 0x06, 0x9d, 0xb2, 0xdd, 0xab, 0xde, 0xf9, 0x70, 0x68,
-	0x15, 0xa2, 0xd7, 0xa5, 0x3b, 0xa9, 0x0c, 0xcd, 0x50, 0x3a, 0xb0, 0x7d, 0x99, 0x5d, 0x56, 0xc9,
-	0x01, 0x39, 0xde, 0xe8, 0x54, 0xdf, 0x5f, 0x5b, 0x95, 0x3c, 0x23, 0xc7, 0x2f, 0x9d, 0x35, 0xb1,
-	0xf6, 0xb7, 0x97, 0x4f, 0x0a, 0x9b, 0x06, 0xdd, 0x54, 0x69, 0xb4, 0x34, 0x28, 0xcf, 0x0d, 0x7c,
-	0xaa, 0xd2, 0x28, 0x07, 0x9a, 0x0d, 0xba, 0xbf, 0xb2, 0x01, 0x5f, 0x61, 0x02, 0x31, 0xaa, 0x93,
-	0x17, 0x42, 0xd7, 0x7a, 0xa8, 0xbd, 0x27, 0x42, 0xbd, 0x15, 0x7d, 0x1e, 0xf1, 0x3f, 0x13, 0xe2,
-	0x2b, 0xed, 0x6a, 0xfc, 0x7f, 0x5c, 0x11, 0xdb, 0x3c, 0x7c, 0xf8, 0xf8, 0x7e, 0x2e, 0x33, 0xaf,
-	0x5e, 0xac, 0xc4, 0xe6, 0x6c, 0xff, 0xd7, 0x7f, 0x3a, 0x17, 0x6f, 0x53, 0x46, 0x26, 0x53, 0x46,
-	0xbe, 0xa6, 0x8c, 0x3c, 0xce, 0x58, 0x69, 0x32, 0x63, 0xa5, 0xcf, 0x19, 0x2b, 0x5d, 0x9f, 0x69,
-	0xe3, 0x6e, 0x6e, 0x03, 0x3e, 0x80, 0x48, 0x14, 0xc9, 0x60, 0xf5, 0xb2, 0x6e, 0xc9, 0x24, 0x11,
-	0x63, 0x11, 0x84, 0x10, 0xa0, 0xb3, 0x4a, 0x46, 0xc2, 0xdd, 0x25, 0x0a, 0x83, 0xf5, 0xc5, 0x5e,
-	0x4e, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x18, 0x1a, 0xed, 0x13, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcd, 0xea, 0x13, 0x31,
+	0x14, 0xc5, 0x9b, 0xfe, 0x55, 0x68, 0x14, 0xd4, 0xa1, 0xc2, 0x38, 0xd4, 0x69, 0x19, 0xfc, 0xe8,
+	0xa6, 0x13, 0xaa, 0xd0, 0x7d, 0x0b, 0x5d, 0x16, 0x64, 0x0a, 0x2e, 0xdc, 0x94, 0xcc, 0x34, 0xc4,
+	0xc0, 0x4c, 0xee, 0x34, 0x49, 0x87, 0xba, 0x12, 0xc4, 0x07, 0x10, 0xdd, 0xb9, 0xf3, 0x1d, 0x7c,
+	0x08, 0x97, 0x45, 0x37, 0x2e, 0xa5, 0xf5, 0x41, 0xa4, 0xf3, 0xa5, 0xc8, 0x58, 0x14, 0xfe, 0xbb,
+	0x24, 0xe7, 0x97, 0x7b, 0x4f, 0x4e, 0x2e, 0xb6, 0x23, 0x16, 0x33, 0x6d, 0x04, 0x25, 0x1b, 0x1e,
+	0x92, 0x6c, 0x4c, 0xcc, 0xce, 0x4f, 0x15, 0x18, 0xb0, 0x6e, 0x56, 0x8a, 0xbf, 0xe1, 0xa1, 0x9f,
+	0x8d, 0x9d, 0x2e, 0x07, 0x0e, 0xb9, 0x46, 0x4e, 0xab, 0x02, 0x73, 0xee, 0x46, 0xa0, 0x13, 0xd0,
+	0xab, 0x42, 0x28, 0x36, 0xa5, 0xd4, 0xe3, 0x00, 0x3c, 0x66, 0x84, 0xa6, 0x82, 0x50, 0x29, 0xc1,
+	0x50, 0x23, 0x40, 0x96, 0xaa, 0xf7, 0x0a, 0xdf, 0x59, 0x68, 0x1e, 0x30, 0x2e, 0xb4, 0x61, 0x6a,
+	0xfe, 0x6c, 0x31, 0x5d, 0xaf, 0x15, 0xd3, 0xda, 0x9a, 0xe3, 0xdb, 0x19, 0x8d, 0xc5, 0x9a, 0x1a,
+	0x50, 0x2b, 0x5a, 0x1c, 0xda, 0x68, 0x80, 0x86, 0x9d, 0x99, 0xfd, 0xe5, 0xd3, 0xa8, 0x5b, 0xf6,
+	0x28, 0xf1, 0xa5, 0x51, 0x42, 0xf2, 0xe0, 0x56, 0x7d, 0xa5, 0x2a, 0xd3, 0xc7, 0xd7, 0x59, 0x96,
+	0xd4, 0x05, 0xda, 0xa7, 0x02, 0x01, 0x66, 0x59, 0x52, 0x02, 0x5e, 0x1f, 0xdf, 0x6b, 0x34, 0x10,
+	0x30, 0x9d, 0x82, 0xd4, 0xcc, 0xfb, 0x80, 0x72, 0x62, 0xb9, 0x0d, 0x13, 0x61, 0xa6, 0xc6, 0x30,
+	0x5d, 0xbc, 0x60, 0x29, 0xb8, 0xa4, 0x66, 0xab, 0xd8, 0x65, 0x59, 0xed, 0xe2, 0xab, 0x12, 0x64,
+	0xc4, 0x72, 0x93, 0x57, 0x82, 0x62, 0x63, 0xf5, 0x70, 0x47, 0x57, 0x9d, 0xec, 0x8b, 0x01, 0x1a,
+	0xde, 0x08, 0x7e, 0x1d, 0x78, 0x8f, 0xf0, 0x83, 0xb3, 0xde, 0xaa, 0x57, 0x3c, 0xfe, 0xd8, 0xc6,
+	0x17, 0x0b, 0xcd, 0xad, 0x77, 0x08, 0x5b, 0x0d, 0x69, 0x3f, 0xf4, 0xff, 0xf8, 0x67, 0xbf, 0x31,
+	0x14, 0xc7, 0xff, 0x37, 0xae, 0x0e, 0xef, 0xfe, 0xeb, 0xaf, 0x3f, 0xde, 0xb7, 0x5d, 0xab, 0x57,
+	0x0d, 0x96, 0x2a, 0xd9, 0xd5, 0x6f, 0xbf, 0x62, 0xbd, 0x41, 0xd8, 0x39, 0x93, 0x6f, 0x63, 0xd3,
+	0xbf, 0xf3, 0xce, 0xe4, 0xff, 0xf8, 0xca, 0xec, 0xec, 0xe9, 0xe7, 0x83, 0x8b, 0xf6, 0x07, 0x17,
+	0x7d, 0x3f, 0xb8, 0xe8, 0xed, 0xd1, 0x6d, 0xed, 0x8f, 0x6e, 0xeb, 0xdb, 0xd1, 0x6d, 0x3d, 0x9f,
+	0x70, 0x61, 0x5e, 0x6c, 0x43, 0x3f, 0x82, 0x84, 0x54, 0xb5, 0x41, 0xf1, 0x7a, 0x3d, 0xa2, 0x69,
+	0x4a, 0x76, 0x24, 0x8c, 0x21, 0xd4, 0x46, 0x31, 0x9a, 0x10, 0xf3, 0x32, 0x65, 0x3a, 0xbc, 0x96,
+	0x0f, 0xf9, 0x93, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xba, 0xef, 0x5c, 0x44, 0x60, 0x03, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +284,12 @@ type MsgClient interface {
 	// There are no validity checks of the EVM addresses existence on the Ethereum
 	// state machine.
 	RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAddress, opts ...grpc.CallOption) (*MsgRegisterEVMAddressResponse, error)
+	// SubmitAttestationSignature records a validator's EVM signature over the
+	// digest of an attestation. The signature is verified against the EVM
+	// address registered by the validator and the validator must be a member of
+	// the validator set that the Blobstream contract checks the attestation
+	// against.
+	SubmitAttestationSignature(ctx context.Context, in *MsgSubmitAttestationSignature, opts ...grpc.CallOption) (*MsgSubmitAttestationSignatureResponse, error)
 }
 
 type msgClient struct {
@@ -191,6 +309,15 @@ func (c *msgClient) RegisterEVMAddress(ctx context.Context, in *MsgRegisterEVMAd
 	return out, nil
 }
 
+func (c *msgClient) SubmitAttestationSignature(ctx context.Context, in *MsgSubmitAttestationSignature, opts ...grpc.CallOption) (*MsgSubmitAttestationSignatureResponse, error) {
+	out := new(MsgSubmitAttestationSignatureResponse)
+	err := c.cc.Invoke(ctx, "/celestia.qgb.v1.Msg/SubmitAttestationSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterEVMAddress records an evm address for the validator which is used
@@ -199,6 +326,12 @@ type MsgServer interface {
 	// There are no validity checks of the EVM addresses existence on the Ethereum
 	// state machine.
 	RegisterEVMAddress(context.Context, *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error)
+	// SubmitAttestationSignature records a validator's EVM signature over the
+	// digest of an attestation. The signature is verified against the EVM
+	// address registered by the validator and the validator must be a member of
+	// the validator set that the Blobstream contract checks the attestation
+	// against.
+	SubmitAttestationSignature(context.Context, *MsgSubmitAttestationSignature) (*MsgSubmitAttestationSignatureResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +341,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RegisterEVMAddress(ctx context.Context, req *MsgRegisterEVMAddress) (*MsgRegisterEVMAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterEVMAddress not implemented")
 }
+func (*UnimplementedMsgServer) SubmitAttestationSignature(ctx context.Context, req *MsgSubmitAttestationSignature) (*MsgSubmitAttestationSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestationSignature not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +367,24 @@ func _Msg_RegisterEVMAddress_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitAttestationSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestationSignature)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestationSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.qgb.v1.Msg/SubmitAttestationSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestationSignature(ctx, req.(*MsgSubmitAttestationSignature))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.qgb.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +393,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterEVMAddress",
 			Handler:    _Msg_RegisterEVMAddress_Handler,
 		},
+		{
+			MethodName: "SubmitAttestationSignature",
+			Handler:    _Msg_SubmitAttestationSignature_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/qgb/v1/tx.proto",
@@ -304,6 +462,71 @@ func (m *MsgRegisterEVMAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitAttestationSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestationSignatureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestationSignatureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestationSignatureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +564,35 @@ func (m *MsgRegisterEVMAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitAttestationSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestationSignatureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -511,6 +763,191 @@ func (m *MsgRegisterEVMAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitAttestationSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestationSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestationSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAttestationSignatureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestationSignatureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestationSignatureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -240,43 +240,192 @@ func (m *DataCommitment) GetTime() time.Time {
 	return time.Time{}
 }
 
+// AttestationConfirm is a validator's verified signature over the digest of an
+// attestation.
+type AttestationConfirm struct {
+	// Nonce of the signed attestation.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// The operating address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// EVM address that signed the attestation.
+	EvmAddress string `protobuf:"bytes,3,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+	// The 65 bytes [R || S || V] ECDSA signature.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Normalized power of the validator in the validator set that the
+	// attestation is checked against.
+	Power uint64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *AttestationConfirm) Reset()         { *m = AttestationConfirm{} }
+func (m *AttestationConfirm) String() string { return proto.CompactTextString(m) }
+func (*AttestationConfirm) ProtoMessage()    {}
+func (*AttestationConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5db0e6d49b998544, []int{3}
+}
+func (m *AttestationConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationConfirm.Merge(m, src)
+}
+func (m *AttestationConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationConfirm proto.InternalMessageInfo
+
+func (m *AttestationConfirm) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AttestationConfirm) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *AttestationConfirm) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
+func (m *AttestationConfirm) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *AttestationConfirm) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// EVMAddressRecord is an entry of the history of the EVM addresses of a
+// validator.
+type EVMAddressRecord struct {
+	// Height at which the EVM address was set. Addresses set before the history
+	// was kept have a height of 0.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The HEX encoded EVM address.
+	EvmAddress string `protobuf:"bytes,2,opt,name=evm_address,json=evmAddress,proto3" json:"evm_address,omitempty"`
+}
+
+func (m *EVMAddressRecord) Reset()         { *m = EVMAddressRecord{} }
+func (m *EVMAddressRecord) String() string { return proto.CompactTextString(m) }
+func (*EVMAddressRecord) ProtoMessage()    {}
+func (*EVMAddressRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5db0e6d49b998544, []int{4}
+}
+func (m *EVMAddressRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EVMAddressRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EVMAddressRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EVMAddressRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMAddressRecord.Merge(m, src)
+}
+func (m *EVMAddressRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EVMAddressRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMAddressRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMAddressRecord proto.InternalMessageInfo
+
+func (m *EVMAddressRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EVMAddressRecord) GetEvmAddress() string {
+	if m != nil {
+		return m.EvmAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BridgeValidator)(nil), "celestia.qgb.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "celestia.qgb.v1.Valset")
 	proto.RegisterType((*DataCommitment)(nil), "celestia.qgb.v1.DataCommitment")
+	proto.RegisterType((*AttestationConfirm)(nil), "celestia.qgb.v1.AttestationConfirm")
+	proto.RegisterType((*EVMAddressRecord)(nil), "celestia.qgb.v1.EVMAddressRecord")
 }
 
 func init() { proto.RegisterFile("celestia/qgb/v1/types.proto", fileDescriptor_5db0e6d49b998544) }
 
 var fileDescriptor_5db0e6d49b998544 = []byte{
-	// 424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x6b, 0x16, 0xca, 0xe6, 0x4a, 0x4c, 0x0a, 0x13, 0x94, 0x4e, 0x4a, 0xaa, 0x9e, 0x7a,
-	0x99, 0xad, 0x0d, 0x09, 0x21, 0x4e, 0x2c, 0x70, 0x80, 0x1b, 0x8a, 0xd0, 0x0e, 0x5c, 0x2a, 0x3b,
-	0xf9, 0x70, 0x2d, 0xe2, 0x7c, 0x69, 0xec, 0x06, 0xf8, 0x17, 0xfb, 0x31, 0x48, 0xfc, 0x85, 0x09,
-	0x2e, 0x3b, 0x72, 0x02, 0xd4, 0xfe, 0x11, 0x94, 0xb8, 0xe1, 0x30, 0x89, 0xdb, 0x6e, 0x7e, 0xbf,
-	0xc7, 0xdf, 0xab, 0xf7, 0xb5, 0x4c, 0x8f, 0x33, 0x28, 0xc0, 0x3a, 0x2d, 0xf8, 0x4a, 0x49, 0xde,
-	0x9c, 0x72, 0xf7, 0xa5, 0x02, 0xcb, 0xaa, 0x1a, 0x1d, 0x86, 0x87, 0x3d, 0x64, 0x2b, 0x25, 0x59,
-	0x73, 0x3a, 0x39, 0x52, 0xa8, 0xb0, 0x63, 0xbc, 0x3d, 0xf9, 0x6b, 0x93, 0xc7, 0x19, 0x5a, 0x83,
-	0x76, 0xe1, 0x81, 0x17, 0x3b, 0x14, 0x2b, 0x44, 0x55, 0x00, 0xef, 0x94, 0x5c, 0x7f, 0xe0, 0x4e,
-	0x1b, 0xb0, 0x4e, 0x98, 0xca, 0x5f, 0x98, 0xbd, 0xa6, 0x87, 0x49, 0xad, 0x73, 0x05, 0x17, 0xa2,
-	0xd0, 0xb9, 0x70, 0x58, 0x87, 0x47, 0xf4, 0x6e, 0x85, 0x9f, 0xa0, 0x1e, 0x93, 0x29, 0x99, 0x07,
-	0xa9, 0x17, 0x61, 0x4c, 0x47, 0xd0, 0x98, 0x85, 0xc8, 0xf3, 0x1a, 0xac, 0x1d, 0xdf, 0x99, 0x92,
-	0xf9, 0x41, 0x4a, 0xa1, 0x31, 0xe7, 0x7e, 0x32, 0xfb, 0x41, 0xe8, 0xf0, 0x42, 0x14, 0x16, 0x5c,
-	0xeb, 0x50, 0x62, 0x99, 0x41, 0xef, 0xd0, 0x89, 0xf0, 0x05, 0xbd, 0x67, 0xc0, 0x48, 0xa8, 0xdb,
-	0xed, 0xbd, 0xf9, 0xe8, 0x6c, 0xca, 0x6e, 0xf4, 0x63, 0x37, 0xa2, 0x24, 0xc1, 0xd5, 0xaf, 0x78,
-	0x90, 0xf6, 0x6b, 0xe1, 0x43, 0x3a, 0x5c, 0x82, 0x56, 0x4b, 0x37, 0xde, 0xeb, 0x8c, 0x77, 0x2a,
-	0x7c, 0x46, 0x83, 0xb6, 0xd7, 0x38, 0x98, 0x92, 0xf9, 0xe8, 0x6c, 0xc2, 0x7c, 0x69, 0xd6, 0x97,
-	0x66, 0xef, 0xfa, 0xd2, 0xc9, 0x7e, 0x6b, 0x78, 0xf9, 0x3b, 0x26, 0x69, 0xb7, 0xf1, 0xfc, 0xd1,
-	0xf7, 0xaf, 0x27, 0x0f, 0xce, 0x9d, 0x6b, 0xb1, 0xd3, 0x58, 0xa6, 0xb0, 0x5a, 0x83, 0x75, 0x6f,
-	0x66, 0xdf, 0x08, 0xbd, 0xff, 0x4a, 0x38, 0xf1, 0x12, 0x8d, 0xd1, 0xce, 0x40, 0xf9, 0xbf, 0x56,
-	0x31, 0x1d, 0x49, 0x50, 0xba, 0x5c, 0xc8, 0x02, 0xb3, 0x8f, 0xdd, 0xbb, 0x04, 0x29, 0xed, 0x46,
-	0x49, 0x3b, 0x09, 0x8f, 0xe9, 0x01, 0x94, 0xf9, 0x0e, 0xfb, 0xdc, 0xfb, 0x50, 0xe6, 0x1e, 0xde,
-	0x7e, 0xf2, 0xe4, 0xed, 0xd5, 0x26, 0x22, 0xd7, 0x9b, 0x88, 0xfc, 0xd9, 0x44, 0xe4, 0x72, 0x1b,
-	0x0d, 0xae, 0xb7, 0xd1, 0xe0, 0xe7, 0x36, 0x1a, 0xbc, 0x7f, 0xaa, 0xb4, 0x5b, 0xae, 0x25, 0xcb,
-	0xd0, 0xf0, 0xfe, 0xe5, 0xb1, 0x56, 0xff, 0xce, 0x27, 0xa2, 0xaa, 0xf8, 0x67, 0x2e, 0x0b, 0x94,
-	0xd6, 0xd5, 0x20, 0x8c, 0xff, 0x8c, 0x72, 0xd8, 0xc5, 0x79, 0xf2, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0xb6, 0x7e, 0xfe, 0xac, 0x02, 0x00, 0x00,
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0x59, 0x57, 0x56, 0x17, 0xb1, 0x11, 0x2a, 0x28, 0x1d, 0x4a, 0xab, 0x9e, 0x7a, 0x69,
+	0xa2, 0x0d, 0x09, 0x21, 0x4e, 0x2c, 0x63, 0x12, 0x08, 0x21, 0xa1, 0x80, 0x7a, 0xe0, 0x52, 0x39,
+	0xc9, 0x9b, 0x6b, 0x11, 0xc7, 0xa9, 0xed, 0x06, 0xf8, 0x17, 0xfb, 0x31, 0x93, 0xf8, 0x07, 0x68,
+	0x82, 0xcb, 0xc4, 0x89, 0x13, 0xa0, 0xf6, 0x8f, 0xa0, 0xc4, 0xc9, 0xd6, 0x55, 0x9a, 0x38, 0x71,
+	0xf3, 0xfb, 0x3e, 0xbf, 0x4f, 0xef, 0xf3, 0xfb, 0x8c, 0x77, 0x43, 0x88, 0x41, 0x69, 0x46, 0xdc,
+	0x19, 0x0d, 0xdc, 0x6c, 0xcf, 0xd5, 0x9f, 0x53, 0x50, 0x4e, 0x2a, 0x85, 0x16, 0xd6, 0x76, 0x45,
+	0x3a, 0x33, 0x1a, 0x38, 0xd9, 0x5e, 0xb7, 0x4d, 0x05, 0x15, 0x05, 0xe7, 0xe6, 0x27, 0x73, 0xad,
+	0xfb, 0x20, 0x14, 0x8a, 0x0b, 0x35, 0x31, 0x84, 0x29, 0x4a, 0xaa, 0x47, 0x85, 0xa0, 0x31, 0xb8,
+	0x45, 0x15, 0xcc, 0x8f, 0x5d, 0xcd, 0x38, 0x28, 0x4d, 0x78, 0x6a, 0x2e, 0x0c, 0x5e, 0xe0, 0x6d,
+	0x4f, 0xb2, 0x88, 0xc2, 0x98, 0xc4, 0x2c, 0x22, 0x5a, 0x48, 0xab, 0x8d, 0x37, 0x53, 0xf1, 0x11,
+	0x64, 0x07, 0xf5, 0xd1, 0xb0, 0xee, 0x9b, 0xc2, 0xea, 0xe1, 0x16, 0x64, 0x7c, 0x42, 0xa2, 0x48,
+	0x82, 0x52, 0x9d, 0x1b, 0x7d, 0x34, 0x6c, 0xfa, 0x18, 0x32, 0x7e, 0x60, 0x90, 0xc1, 0x77, 0x84,
+	0x1b, 0x63, 0x12, 0x2b, 0xd0, 0xb9, 0x42, 0x22, 0x92, 0x10, 0x2a, 0x85, 0xa2, 0xb0, 0x9e, 0xe1,
+	0x9b, 0x1c, 0x78, 0x00, 0x32, 0xef, 0xde, 0x18, 0xb6, 0xf6, 0xfb, 0xce, 0x9a, 0x3f, 0x67, 0x6d,
+	0x14, 0xaf, 0x7e, 0xf6, 0xab, 0x57, 0xf3, 0xab, 0x36, 0xeb, 0x1e, 0x6e, 0x4c, 0x81, 0xd1, 0xa9,
+	0xee, 0x6c, 0x14, 0xc2, 0x65, 0x65, 0x3d, 0xc1, 0xf5, 0xdc, 0x57, 0xa7, 0xde, 0x47, 0xc3, 0xd6,
+	0x7e, 0xd7, 0x31, 0xa6, 0x9d, 0xca, 0xb4, 0xf3, 0xae, 0x32, 0xed, 0x6d, 0xe5, 0x82, 0x27, 0xbf,
+	0x7b, 0xc8, 0x2f, 0x3a, 0x9e, 0xde, 0xff, 0x76, 0x3a, 0xba, 0x7b, 0xa0, 0x75, 0x4e, 0x6b, 0x26,
+	0x12, 0x1f, 0x66, 0x73, 0x50, 0xfa, 0xe5, 0xe0, 0x0b, 0xc2, 0xb7, 0x9f, 0x13, 0x4d, 0x0e, 0x05,
+	0xe7, 0x4c, 0x73, 0x48, 0xae, 0x73, 0xd5, 0xc3, 0xad, 0x00, 0x28, 0x4b, 0x26, 0x41, 0x2c, 0xc2,
+	0x0f, 0xc5, 0xbb, 0xd4, 0x7d, 0x5c, 0x40, 0x5e, 0x8e, 0x58, 0xbb, 0xb8, 0x09, 0x49, 0x54, 0xd2,
+	0x66, 0xee, 0x2d, 0x48, 0x22, 0x43, 0xfe, 0x87, 0xc9, 0xbf, 0x22, 0x6c, 0xad, 0xe0, 0x87, 0x22,
+	0x39, 0x66, 0x92, 0x5f, 0x33, 0xfd, 0x11, 0xbe, 0x93, 0x55, 0xaf, 0x7d, 0x75, 0xb7, 0x5e, 0xe7,
+	0xc7, 0xe9, 0xa8, 0x5d, 0x86, 0xa9, 0xdc, 0xf1, 0x5b, 0x2d, 0x59, 0x42, 0xfd, 0x9d, 0x8b, 0x96,
+	0x12, 0x5f, 0x0f, 0xc7, 0xc6, 0x7a, 0x38, 0xac, 0x87, 0xb8, 0xa9, 0x18, 0x4d, 0x88, 0x9e, 0x4b,
+	0x63, 0xf6, 0x96, 0x7f, 0x09, 0x5c, 0x26, 0x6e, 0x73, 0x25, 0x71, 0x83, 0x57, 0x78, 0xe7, 0x68,
+	0xfc, 0xba, 0x54, 0xf0, 0x21, 0x14, 0x32, 0x5a, 0x49, 0x00, 0xba, 0x92, 0x80, 0x7f, 0xa5, 0xd3,
+	0x7b, 0x73, 0xb6, 0xb0, 0xd1, 0xf9, 0xc2, 0x46, 0x7f, 0x16, 0x36, 0x3a, 0x59, 0xda, 0xb5, 0xf3,
+	0xa5, 0x5d, 0xfb, 0xb9, 0xb4, 0x6b, 0xef, 0x1f, 0x53, 0xa6, 0xa7, 0xf3, 0xc0, 0x09, 0x05, 0x77,
+	0xab, 0x3c, 0x0a, 0x49, 0x2f, 0xce, 0x23, 0x92, 0xa6, 0xee, 0x27, 0x37, 0x88, 0x45, 0xa0, 0xb4,
+	0x04, 0xc2, 0xcd, 0x17, 0x0d, 0x1a, 0xc5, 0x92, 0x1e, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x47,
+	0xda, 0x5b, 0xc9, 0xc2, 0x03, 0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -415,6 +564,95 @@ func (m *DataCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttestationConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EVMAddressRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EVMAddressRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EVMAddressRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmAddress) > 0 {
+		i -= len(m.EvmAddress)
+		copy(dAtA[i:], m.EvmAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -485,6 +723,49 @@ func (m *DataCommitment) Size() (n int) {
 	return n
 }
 
+func (m *AttestationConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *EVMAddressRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -887,6 +1168,293 @@ func (m *DataCommitment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AttestationConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EVMAddressRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EVMAddressRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EVMAddressRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12,9 +12,10 @@ import (
 )
 
 // ParamBlockList keeps track of parameters that cannot be changed by governance
-// proposals
+// proposals, either at all or before a given app version.
 type ParamBlockList struct {
-	params map[string]bool
+	params      map[string]bool
+	minVersions map[string]uint64
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
@@ -27,11 +28,35 @@ func NewParamBlockList(blockedParams ...[2]string) ParamBlockList {
 	return ParamBlockList{params: consolidatedParams}
 }
 
+// WithMinAppVersion returns a copy of the ParamBlockList that also blocks the
+// provided parameters before the provided app version.
+func (pbl ParamBlockList) WithMinAppVersion(appVersion uint64, params ...[2]string) ParamBlockList {
+	minVersions := make(map[string]uint64, len(pbl.minVersions)+len(params))
+	for param, version := range pbl.minVersions {
+		minVersions[param] = version
+	}
+	for _, param := range params {
+		minVersions[fmt.Sprintf("%s-%s", param[0], param[1])] = appVersion
+	}
+	pbl.minVersions = minVersions
+	return pbl
+}
+
 // IsBlocked returns true if the given parameter is blocked.
 func (pbl ParamBlockList) IsBlocked(subspace string, key string) bool {
 	return pbl.params[fmt.Sprintf("%s-%s", subspace, key)]
 }
 
+// IsBlockedAt returns true if the given parameter is blocked at the provided
+// app version.
+func (pbl ParamBlockList) IsBlockedAt(subspace string, key string, appVersion uint64) bool {
+	if pbl.IsBlocked(subspace, key) {
+		return true
+	}
+	minVersion, ok := pbl.minVersions[fmt.Sprintf("%s-%s", subspace, key)]
+	return ok && appVersion < minVersion
+}
+
 // GovHandler creates a new governance Handler for a ParamChangeProposal using
 // the underlying ParamBlockList.
 func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
@@ -56,6 +81,9 @@ func (pbl ParamBlockList) handleParameterChangeProposal(
 		if pbl.IsBlocked(c.Subspace, c.Key) {
 			return ErrBlockedParameter
 		}
+		if pbl.IsBlockedAt(c.Subspace, c.Key, ctx.BlockHeader().Version.App) {
+			return sdkerrors.Wrapf(ErrBlockedParameter, "%s %s can't be changed at app version %d", c.Subspace, c.Key, ctx.BlockHeader().Version.App)
+		}
 	}
 
 	for _, c := range p.Changes {
@@ -2,9 +2,13 @@ package test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/app"
+	v1 "github.com/celestiaorg/celestia-app/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
 	testutil "github.com/celestiaorg/celestia-app/test/util"
+	bstypes "github.com/celestiaorg/celestia-app/x/blobstream/types"
 	"github.com/celestiaorg/celestia-app/x/paramfilter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -12,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func TestParamFilter(t *testing.T) {
@@ -60,6 +65,50 @@ func TestParamFilter(t *testing.T) {
 	}
 }
 
+func TestParamFilterBlobstreamParams(t *testing.T) {
+	app, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pph := paramfilter.NewParamBlockList(app.BlockedParams()...).WithMinAppVersion(v2.Version, app.V2Params()...)
+	handler := pph.GovHandler(app.ParamsKeeper)
+	ctx := sdk.NewContext(app.CommitMultiStore(), types.Header{Version: version.Consensus{App: v1.Version}}, false, tmlog.NewNopLogger())
+
+	// the params added in v2 can't be changed before v2
+	windowChange := proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeyDataCommitmentWindow), `"400"`)
+	require.NoError(t, handler(ctx, testProposal(windowChange)))
+	for _, p := range app.V2Params() {
+		require.True(t, pph.IsBlockedAt(p[0], p[1], v1.Version))
+		err := handler(ctx, testProposal(proposal.NewParamChange(p[0], p[1], `"1"`)))
+		require.ErrorIs(t, err, paramfilter.ErrBlockedParameter)
+	}
+	require.Equal(t, uint64(400), app.BlobstreamKeeper.GetParams(ctx).DataCommitmentWindow)
+
+	// the blobstream params can be changed by governance from v2
+	ctx = ctx.WithBlockHeader(types.Header{Version: version.Consensus{App: v2.Version}})
+	changes := []proposal.ParamChange{
+		proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeyDataCommitmentWindow), `"500"`),
+		proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeyAttestationExpiryTime), `"3600000000000"`),
+		proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeySignificantPowerDifferenceThreshold), `"0.1"`),
+	}
+	for _, change := range changes {
+		require.False(t, pph.IsBlockedAt(change.Subspace, change.Key, v2.Version))
+	}
+	require.NoError(t, handler(ctx, testProposal(changes...)))
+
+	params := app.BlobstreamKeeper.GetParams(ctx)
+	require.Equal(t, uint64(500), params.DataCommitmentWindow)
+	require.Equal(t, time.Hour, params.AttestationExpiryTime)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), params.SignificantPowerDifferenceThreshold)
+
+	// invalid values are rejected
+	invalidChanges := []proposal.ParamChange{
+		proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeyAttestationExpiryTime), `"0"`),
+		proposal.NewParamChange(bstypes.ModuleName, string(bstypes.ParamsStoreKeySignificantPowerDifferenceThreshold), `"1.5"`),
+	}
+	for _, change := range invalidChanges {
+		require.Error(t, handler(ctx, testProposal(change)))
+	}
+	require.Equal(t, params, app.BlobstreamKeeper.GetParams(ctx))
+}
+
 func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
 	return proposal.NewParameterChangeProposal("title", "description", changes)
 }
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for this module
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        upgrade.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", upgrade.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryTally(), CmdQueryTallies(), CmdQuerySchedule())
+
+	return cmd
+}
+
+func CmdQueryTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally [version]",
+		Short: "Query the voting power that signalled an app version",
+		Long: `Queries the voting power of the validators that signalled the app version,
+along with the voting power that must signal it for the queried node to propose
+upgrading to it.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := upgrade.NewQueryClient(clientCtx)
+			res, err := queryClient.VersionTally(cmd.Context(), &upgrade.QueryVersionTallyRequest{Version: version})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryTallies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tallies",
+		Short: "Query the voting power that signalled each app version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := upgrade.NewQueryClient(clientCtx)
+			res, err := queryClient.VersionTallies(cmd.Context(), &upgrade.QueryVersionTalliesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuerySchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Query the upgrade schedule configured by the node",
+		Long: `Queries the upgrade schedule configured by the queried node for the chain, along
+with the next planned app version, whether the current height falls in the window of
+a plan and the current app version. The schedule is local per node so querying each
+validator confirms that they carry the same schedule ahead of an upgrade.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := upgrade.NewQueryClient(clientCtx)
+			res, err := queryClient.Schedule(cmd.Context(), &upgrade.QueryScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/celestiaorg/celestia-app/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        upgrade.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", upgrade.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdSignalVersion())
+
+	return cmd
+}
+
+func CmdSignalVersion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal [version]",
+		Short: "Signal that a validator is ready to upgrade to an app version",
+		Long: `Signals that the validator operated by the signer supports the app version and
+is ready to upgrade to it. The network upgrades once the validators that signalled
+the version hold enough voting power. To change the version, the validator can
+simply send a new message overriding the previous one.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := upgrade.NewMsgSignalVersion(sdk.ValAddress(clientCtx.GetFromAddress()), version)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 )
@@ -20,14 +21,42 @@ type Keeper struct {
 	// and configured from the config.
 	upgradeSchedule map[string]Schedule
 
+	// chainID is the chain ID of the node, used to select its upgrade
+	// schedule in queries. It is captured at construction as the context of
+	// queries has no chain ID until a block is committed after a restart.
+	chainID string
+
 	// the app version that should be set in end blocker
 	pendingAppVersion uint64
+
+	// stakingKeeper provides the voting power of the validators that signal
+	// versions.
+	stakingKeeper StakingKeeper
+
+	// appVersion returns the current app version.
+	appVersion VersionGetter
+}
+
+// StakingKeeper is the subset of the staking keeper used to tally the voting
+// power of the validators that signal versions.
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+	GetLastTotalPower(ctx sdk.Context) sdk.Int
 }
 
 type VersionSetter func(version uint64)
 
+type VersionGetter func() uint64
+
 // NewKeeper constructs an upgrade keeper
-func NewKeeper(storeKey storetypes.StoreKey, upgradeSchedule map[string]Schedule) Keeper {
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	upgradeSchedule map[string]Schedule,
+	chainID string,
+	stakingKeeper StakingKeeper,
+	appVersion VersionGetter,
+) Keeper {
 	for chainID, schedule := range upgradeSchedule {
 		if err := schedule.ValidateBasic(); err != nil {
 			panic(fmt.Sprintf("invalid schedule %s: %v", chainID, err))
@@ -36,6 +65,9 @@ func NewKeeper(storeKey storetypes.StoreKey, upgradeSchedule map[string]Schedule
 	return Keeper{
 		storeKey:        storeKey,
 		upgradeSchedule: upgradeSchedule,
+		chainID:         chainID,
+		stakingKeeper:   stakingKeeper,
+		appVersion:      appVersion,
 	}
 }
 
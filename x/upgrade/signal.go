@@ -0,0 +1,197 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"cosmossdk.io/errors"
+	v2 "github.com/celestiaorg/celestia-app/pkg/appconsts/v2"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SignalKeyPrefix is the prefix of the keys storing the version signalled by
+// each validator. It doesn't collide with the prefixes of the standard upgrade
+// module, whose store is reused by this module.
+var SignalKeyPrefix = []byte{0x10}
+
+// signalThresholdNumerator and signalThresholdDenominator define the fraction
+// of the total voting power that must signal a version for the network to
+// upgrade to it. It is a consensus rule: a proposal upgrading to a version
+// that didn't reach it is rejected, so it can only change with an app version.
+const (
+	signalThresholdNumerator   = 5
+	signalThresholdDenominator = 6
+)
+
+// SignalThreshold returns the fraction of the total voting power that must
+// signal a version for the network to upgrade to it.
+func SignalThreshold() sdk.Dec {
+	return sdk.NewDec(signalThresholdNumerator).QuoInt64(signalThresholdDenominator)
+}
+
+var (
+	_ MsgServer   = Keeper{}
+	_ QueryServer = Keeper{}
+)
+
+// RequiresSignal returns true if upgrading from the current app version
+// requires the validators to signal the new version. Signalling was added in
+// v2 so the upgrade from v1 to v2 only follows the upgrade schedule.
+func (k Keeper) RequiresSignal() bool {
+	return k.appVersion() >= v2.Version
+}
+
+// SignalVersion records the version signalled by a validator. The version
+// must be greater than the current app version, which must be v2 or later.
+func (k Keeper) SignalVersion(goCtx context.Context, msg *MsgSignalVersion) (*MsgSignalVersionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.RequiresSignal() {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "signalling is not supported at app version %d", k.appVersion())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, exists := k.stakingKeeper.GetValidator(ctx, valAddr); !exists {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	if currentVersion := k.appVersion(); msg.Version <= currentVersion {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "signalled version %d must be greater than the current app version %d", msg.Version, currentVersion)
+	}
+
+	k.SetVersionSignal(ctx, valAddr, msg.Version)
+	return &MsgSignalVersionResponse{}, nil
+}
+
+// SetVersionSignal records the version signalled by a validator, overriding
+// any previous signal.
+func (k Keeper) SetVersionSignal(ctx sdk.Context, valAddr sdk.ValAddress, version uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), SignalKeyPrefix)
+	store.Set(valAddr, sdk.Uint64ToBigEndian(version))
+}
+
+// GetVersionSignal returns the version signalled by a validator, if any.
+func (k Keeper) GetVersionSignal(ctx sdk.Context, valAddr sdk.ValAddress) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), SignalKeyPrefix)
+	bz := store.Get(valAddr)
+	if len(bz) == 0 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// ResetTally removes the signals of the versions up to the provided version,
+// which are obsolete once the network upgraded to it.
+func (k Keeper) ResetTally(ctx sdk.Context, version uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), SignalKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	var obsolete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if binary.BigEndian.Uint64(iterator.Value()) <= version {
+			obsolete = append(obsolete, iterator.Key())
+		}
+	}
+	for _, key := range obsolete {
+		store.Delete(key)
+	}
+}
+
+// versionTallies returns the voting power of the validators that signalled
+// each version, in increasing version order. Only the validators in the last
+// validator set contribute voting power.
+func (k Keeper) versionTallies(ctx sdk.Context) []VersionTally {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), SignalKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	tallies := make(map[uint64]*VersionTally)
+	for ; iterator.Valid(); iterator.Next() {
+		version := binary.BigEndian.Uint64(iterator.Value())
+		tally, ok := tallies[version]
+		if !ok {
+			tally = &VersionTally{Version: version}
+			tallies[version] = tally
+		}
+		tally.Validators++
+		if power := k.stakingKeeper.GetLastValidatorPower(ctx, sdk.ValAddress(iterator.Key())); power > 0 {
+			tally.VotingPower += uint64(power)
+		}
+	}
+
+	sorted := make([]VersionTally, 0, len(tallies))
+	for _, tally := range tallies {
+		sorted = append(sorted, *tally)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	return sorted
+}
+
+// versionTally returns the voting power of the validators that signalled the
+// version.
+func (k Keeper) versionTally(ctx sdk.Context, version uint64) VersionTally {
+	for _, tally := range k.versionTallies(ctx) {
+		if tally.Version == version {
+			return tally
+		}
+	}
+	return VersionTally{Version: version}
+}
+
+// thresholdPower returns the voting power that must signal a version for the
+// network to upgrade to it, along with the total voting power.
+func (k Keeper) thresholdPower(ctx sdk.Context) (threshold, total uint64) {
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	if !totalPower.IsPositive() {
+		return 0, 0
+	}
+	return SignalThreshold().MulInt(totalPower).Ceil().TruncateInt().Uint64(), totalPower.Uint64()
+}
+
+// HasReachedThreshold returns true if the validators that signalled the
+// version hold at least the signal threshold of the total voting power.
+func (k Keeper) HasReachedThreshold(ctx sdk.Context, version uint64) bool {
+	threshold, _ := k.thresholdPower(ctx)
+	return hasReachedThreshold(k.versionTally(ctx, version), threshold)
+}
+
+// hasReachedThreshold returns true if the tally reached the threshold power.
+func hasReachedThreshold(tally VersionTally, thresholdPower uint64) bool {
+	return tally.VotingPower > 0 && tally.VotingPower >= thresholdPower
+}
+
+// VersionTally implements the Query/VersionTally gRPC method.
+func (k Keeper) VersionTally(goCtx context.Context, req *QueryVersionTallyRequest) (*QueryVersionTallyResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tally := k.versionTally(ctx, req.Version)
+	threshold, total := k.thresholdPower(ctx)
+	return &QueryVersionTallyResponse{
+		Tally:            tally,
+		ThresholdPower:   threshold,
+		TotalVotingPower: total,
+		ThresholdReached: hasReachedThreshold(tally, threshold),
+	}, nil
+}
+
+// VersionTallies implements the Query/VersionTallies gRPC method.
+func (k Keeper) VersionTallies(goCtx context.Context, req *QueryVersionTalliesRequest) (*QueryVersionTalliesResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	threshold, total := k.thresholdPower(ctx)
+	return &QueryVersionTalliesResponse{
+		Tallies:          k.versionTallies(ctx),
+		ThresholdPower:   threshold,
+		TotalVotingPower: total,
+	}, nil
+}
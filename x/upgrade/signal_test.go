@@ -0,0 +1,189 @@
+package upgrade_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/app"
+	"github.com/celestiaorg/celestia-app/app/encoding"
+	"github.com/celestiaorg/celestia-app/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/pkg/da"
+	"github.com/celestiaorg/celestia-app/pkg/shares"
+	"github.com/celestiaorg/celestia-app/pkg/square"
+	"github.com/celestiaorg/celestia-app/pkg/user"
+	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/x/upgrade"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestSignalVersion(t *testing.T) {
+	testApp, _ := setupTestApp(t, nil, 0)
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	valAddr := testApp.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	// signalling isn't supported before v2
+	_, err := testApp.UpgradeKeeper.SignalVersion(goCtx, upgrade.NewMsgSignalVersion(valAddr, 2))
+	require.Error(t, err)
+	testApp.SetProtocolVersion(2)
+
+	// the validator must exist
+	_, err = testApp.UpgradeKeeper.SignalVersion(goCtx, upgrade.NewMsgSignalVersion(sdk.ValAddress([]byte("unknown")), 3))
+	require.Error(t, err)
+	// the version must be greater than the current one
+	_, err = testApp.UpgradeKeeper.SignalVersion(goCtx, upgrade.NewMsgSignalVersion(valAddr, 2))
+	require.Error(t, err)
+
+	tally, err := testApp.UpgradeKeeper.VersionTally(goCtx, &upgrade.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.Equal(t, upgrade.QueryVersionTallyResponse{
+		Tally:            upgrade.VersionTally{Version: 3},
+		ThresholdPower:   1,
+		TotalVotingPower: 1,
+	}, *tally)
+
+	_, err = testApp.UpgradeKeeper.SignalVersion(goCtx, upgrade.NewMsgSignalVersion(valAddr, 3))
+	require.NoError(t, err)
+	version, ok := testApp.UpgradeKeeper.GetVersionSignal(ctx, valAddr)
+	require.True(t, ok)
+	require.EqualValues(t, 3, version)
+
+	tally, err = testApp.UpgradeKeeper.VersionTally(goCtx, &upgrade.QueryVersionTallyRequest{Version: 3})
+	require.NoError(t, err)
+	require.Equal(t, upgrade.QueryVersionTallyResponse{
+		Tally:            upgrade.VersionTally{Version: 3, VotingPower: 1, Validators: 1},
+		ThresholdPower:   1,
+		TotalVotingPower: 1,
+		ThresholdReached: true,
+	}, *tally)
+
+	// a later signal overrides the previous one
+	_, err = testApp.UpgradeKeeper.SignalVersion(goCtx, upgrade.NewMsgSignalVersion(valAddr, 4))
+	require.NoError(t, err)
+	tallies, err := testApp.UpgradeKeeper.VersionTallies(goCtx, &upgrade.QueryVersionTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []upgrade.VersionTally{{Version: 4, VotingPower: 1, Validators: 1}}, tallies.Tallies)
+
+	testApp.UpgradeKeeper.ResetTally(ctx, 4)
+	_, ok = testApp.UpgradeKeeper.GetVersionSignal(ctx, valAddr)
+	require.False(t, ok)
+}
+
+func TestUpgradeRequiresSignal(t *testing.T) {
+	prepareProposal := func(testApp *app.App, height int64) [][]byte {
+		return testApp.PrepareProposal(abci.RequestPrepareProposal{
+			Height:        height,
+			ChainId:       testApp.GetChainID(),
+			BlockData:     &tmproto.Data{},
+			BlockDataSize: 1e6,
+		}).BlockData.Txs
+	}
+
+	// the upgrade from v1 follows the schedule without signals
+	testApp, _ := setupTestApp(t, upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 0)
+	txs := prepareProposal(testApp, 2)
+	require.Len(t, txs, 1)
+	tx, err := testApp.GetTxConfig().TxDecoder()(txs[0])
+	require.NoError(t, err)
+	version, ok := upgrade.IsUpgradeMsg(tx.GetMsgs())
+	require.True(t, ok)
+	require.EqualValues(t, 2, version)
+
+	// and ignores them without a schedule
+	testApp, _ = setupTestApp(t, nil, 2)
+	require.Empty(t, prepareProposal(testApp, 2))
+
+	// from v2, the upgrade isn't proposed within the plan unless the
+	// validators signalled the version
+	shouldProposeUpgrade := func(schedule upgrade.Schedule, signalledVersion uint64) (uint64, bool) {
+		testApp, _ := setupTestApp(t, schedule, signalledVersion)
+		testApp.SetProtocolVersion(2)
+		ctx := testApp.NewContext(true, tmproto.Header{})
+		return testApp.UpgradeKeeper.ShouldProposeUpgrade(ctx, testApp.GetChainID(), 2)
+	}
+	_, ok = shouldProposeUpgrade(upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 0)
+	require.False(t, ok)
+	// nor if they signalled another version
+	_, ok = shouldProposeUpgrade(upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 4)
+	require.False(t, ok)
+	version, ok = shouldProposeUpgrade(upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 2)
+	require.True(t, ok)
+	require.EqualValues(t, 2, version)
+	// without a schedule, the signalled version is proposed at any height
+	version, ok = shouldProposeUpgrade(nil, 4)
+	require.True(t, ok)
+	require.EqualValues(t, 4, version)
+
+	// unless the version isn't supported by the node
+	testApp, _ = setupTestApp(t, nil, 4)
+	testApp.SetProtocolVersion(2)
+	require.Empty(t, prepareProposal(testApp, 2))
+}
+
+func TestProcessProposalRequiresSignal(t *testing.T) {
+	processProposal := func(testApp *app.App, version uint64) abci.ResponseProcessProposal {
+		upgradeTx, err := upgrade.NewMsgVersionChange(testApp.GetTxConfig(), version)
+		require.NoError(t, err)
+		dataSquare, txs, err := square.Build([][]byte{upgradeTx}, appconsts.LatestVersion, appconsts.DefaultGovMaxSquareSize)
+		require.NoError(t, err)
+		eds, err := da.ExtendShares(shares.ToBytes(dataSquare))
+		require.NoError(t, err)
+		dah, err := da.NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		return testApp.ProcessProposal(abci.RequestProcessProposal{
+			Header: tmproto.Header{
+				Height:   2,
+				DataHash: dah.Hash(),
+			},
+			BlockData: &tmproto.Data{
+				Txs:        txs,
+				SquareSize: uint64(dataSquare.Size()),
+				Hash:       dah.Hash(),
+			},
+		})
+	}
+
+	// the upgrade from v1 doesn't require signals
+	testApp, _ := setupTestApp(t, nil, 0)
+	require.False(t, testApp.UpgradeKeeper.RequiresSignal())
+	require.True(t, processProposal(testApp, 2).IsOK())
+
+	// from v2, the proposal is rejected if the validators didn't signal the
+	// version, whatever the schedule of the node
+	testApp, _ = setupTestApp(t, upgrade.NewSchedule(upgrade.NewPlan(1, 5, 2)), 4)
+	testApp.SetProtocolVersion(2)
+	require.True(t, testApp.UpgradeKeeper.RequiresSignal())
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	require.False(t, testApp.UpgradeKeeper.HasReachedThreshold(ctx, 2))
+	require.True(t, testApp.UpgradeKeeper.HasReachedThreshold(ctx, 4))
+}
+
+func TestSignalVersionRejectedBeforeV2(t *testing.T) {
+	testApp, kr := setupTestApp(t, nil, 0)
+	addr := testfactory.GetAddress(kr, "account")
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := user.NewSigner(kr, nil, addr, encCfg.TxConfig, testApp.GetChainID(), 1, 0)
+	require.NoError(t, err)
+	signalTx, err := signer.CreateTx([]sdk.Msg{upgrade.NewMsgSignalVersion(sdk.ValAddress(addr), 2)}, user.SetGasLimitAndFee(1e6, 1))
+	require.NoError(t, err)
+
+	respCheckTx := testApp.CheckTx(abci.RequestCheckTx{Tx: signalTx})
+	require.NotEqualValues(t, 0, respCheckTx.Code, respCheckTx.Log)
+	require.Contains(t, respCheckTx.Log, "only supported from app version 2")
+
+	// a v1 node can't decode the message so delivering it at v1 must leave
+	// the fees and the sequence of the signer untouched
+	testApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2, ChainID: testApp.GetChainID()}})
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	balance := testApp.BankKeeper.GetAllBalances(ctx, addr)
+	sequence := testApp.AccountKeeper.GetAccount(ctx, addr).GetSequence()
+
+	respDeliverTx := testApp.DeliverTx(abci.RequestDeliverTx{Tx: signalTx})
+	require.NotEqualValues(t, 0, respDeliverTx.Code, respDeliverTx.Log)
+	require.Contains(t, respDeliverTx.Log, "only supported from app version 2")
+	ctx = testApp.NewContext(false, tmproto.Header{})
+	require.Equal(t, balance, testApp.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, sequence, testApp.AccountKeeper.GetAccount(ctx, addr).GetSequence())
+}
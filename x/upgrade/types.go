@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -15,7 +17,10 @@ const (
 	ModuleName = upgradetypes.ModuleName
 )
 
-var _ sdk.Msg = &MsgVersionChange{}
+var (
+	_ sdk.Msg = &MsgVersionChange{}
+	_ sdk.Msg = &MsgSignalVersion{}
+)
 
 // TypeRegister is used to register the upgrade module's types in the encoding
 // config without defining an entire module.
@@ -25,13 +30,16 @@ type TypeRegister struct{}
 func (TypeRegister) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(upgradetypes.Plan{}, "cosmos-sdk/Plan", nil)
 	cdc.RegisterConcrete(MsgVersionChange{}, "celestia/MsgVersionChange", nil)
+	cdc.RegisterConcrete(&MsgSignalVersion{}, "celestia/MsgSignalVersion", nil)
 }
 
 // RegisterInterfaces registers the upgrade module types.
 func (TypeRegister) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVersionChange{},
+		&MsgSignalVersion{},
 	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 func (msg *MsgVersionChange) GetSigners() []sdk.AccAddress {
@@ -55,6 +63,34 @@ func NewMsgVersionChange(txConfig client.TxConfig, version uint64) ([]byte, erro
 	return txConfig.TxEncoder()(builder.GetTx())
 }
 
+// NewMsgSignalVersion creates a message signalling that the validator is ready
+// to upgrade to the version.
+func NewMsgSignalVersion(valAddr sdk.ValAddress, version uint64) *MsgSignalVersion {
+	return &MsgSignalVersion{
+		ValidatorAddress: valAddr.String(),
+		Version:          version,
+	}
+}
+
+// GetSigners returns the account of the validator operator.
+func (msg *MsgSignalVersion) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg *MsgSignalVersion) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if msg.Version == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "version cannot be zero")
+	}
+	return nil
+}
+
 func IsUpgradeMsg(msg []sdk.Msg) (uint64, bool) {
 	if len(msg) != 1 {
 		return 0, false
@@ -109,6 +145,28 @@ func (s Schedule) ShouldProposeUpgrade(height int64) (uint64, bool) {
 	return 0, false
 }
 
+// NextVersion returns the version of the first plan upgrading past the app
+// version that hasn't ended at the height, if any.
+func (s Schedule) NextVersion(height int64, appVersion uint64) (uint64, bool) {
+	for _, plan := range s {
+		if plan.Version > appVersion && height <= plan.End {
+			return plan.Version, true
+		}
+	}
+	return 0, false
+}
+
+// InPlanWindow returns true if the height falls between the start and end
+// heights of a plan.
+func (s Schedule) InPlanWindow(height int64) bool {
+	for _, plan := range s {
+		if height >= plan.Start && height <= plan.End {
+			return true
+		}
+	}
+	return false
+}
+
 func (p Plan) ValidateBasic() error {
 	if p.Start < 1 {
 		return fmt.Errorf("plan start height cannot be negative or zero: %d", p.Start)
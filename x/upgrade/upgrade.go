@@ -1,5 +1,13 @@
 package upgrade
 
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 type Schedule []Plan
 
 type Plan struct {
@@ -8,21 +16,60 @@ type Plan struct {
 	Version uint64
 }
 
-// ShouldUpgradeNextHeight returns true if the network of the given chainID should
-// modify the app version in the following block. This can be used for both upgrading
-// and downgrading. This relies on social consensus to work. At least 2/3+ of the
-// validators must have the same app version and height in their schedule for the
-// upgrade to happen successfully.
-func (k Keeper) ShouldProposeUpgrade(chainID string, height int64) (uint64, bool) {
-	if k.upgradeSchedule == nil {
-		return 0, false
+// ShouldProposeUpgrade returns the app version the network of the given
+// chainID should upgrade to in the block following the given height, if any.
+// From v2, this can only be a version signalled by validators holding at least
+// the signal threshold of the voting power, the highest one if several reached
+// it. If the node has a non empty upgrade schedule for the chainID, the version
+// must also be the one of the plan covering the height. Before v2, it is the
+// version of the plan covering the height. The caller is responsible for
+// checking that the version is supported and greater than the current one.
+func (k Keeper) ShouldProposeUpgrade(ctx sdk.Context, chainID string, height int64) (uint64, bool) {
+	if !k.RequiresSignal() {
+		return k.upgradeSchedule[chainID].ShouldProposeUpgrade(height)
 	}
-	if schedule, ok := k.upgradeSchedule[chainID]; ok {
-		return schedule.ShouldProposeUpgrade(height)
+	if schedule := k.upgradeSchedule[chainID]; len(schedule) > 0 {
+		version, ok := schedule.ShouldProposeUpgrade(height)
+		if !ok {
+			return 0, false
+		}
+		return version, k.HasReachedThreshold(ctx, version)
+	}
+
+	threshold, _ := k.thresholdPower(ctx)
+	tallies := k.versionTallies(ctx)
+	for i := len(tallies) - 1; i >= 0; i-- {
+		if hasReachedThreshold(tallies[i], threshold) {
+			return tallies[i].Version, true
+		}
 	}
 	return 0, false
 }
 
+// Schedule implements the Query/Schedule gRPC method. It describes the upgrade
+// schedule of the node for its chain ID.
+func (k Keeper) Schedule(goCtx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	schedule := k.upgradeSchedule[k.chainID]
+	plans := make([]UpgradePlan, len(schedule))
+	for i, plan := range schedule {
+		plans[i] = UpgradePlan{Start: plan.Start, End: plan.End, Version: plan.Version}
+	}
+	appVersion := k.appVersion()
+	nextVersion, _ := schedule.NextVersion(ctx.BlockHeight(), appVersion)
+	return &QueryScheduleResponse{
+		ChainId:      k.chainID,
+		Plans:        plans,
+		Height:       ctx.BlockHeight(),
+		AppVersion:   appVersion,
+		NextVersion:  nextVersion,
+		InPlanWindow: schedule.InPlanWindow(ctx.BlockHeight()),
+	}, nil
+}
+
 func (k *Keeper) PrepareUpgradeAtEndBlock(version uint64) {
 	k.pendingAppVersion = version
 }
@@ -14,7 +14,9 @@ import (
 	"github.com/celestiaorg/celestia-app/pkg/user"
 	"github.com/celestiaorg/celestia-app/test/util"
 	"github.com/celestiaorg/celestia-app/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/test/util/testnode"
 	"github.com/celestiaorg/celestia-app/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -26,7 +28,7 @@ import (
 )
 
 func TestUpgradeAppVersion(t *testing.T) {
-	testApp, kr := setupTestApp(t, upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)))
+	testApp, kr := setupTestApp(t, upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 0)
 	addr := testfactory.GetAddress(kr, "account")
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	signer, err := user.NewSigner(kr, nil, addr, encCfg.TxConfig, testApp.GetChainID(), 1, 0)
@@ -185,7 +187,9 @@ func TestUpgradeAppVersion(t *testing.T) {
 	require.Len(t, respPrepareProposal.BlockData.Txs, 0)
 }
 
-func setupTestApp(t *testing.T, schedule upgrade.Schedule) (*app.App, keyring.Keyring) {
+// setupTestApp returns an app with a single validator that signalled the
+// provided version, unless it is zero.
+func setupTestApp(t *testing.T, schedule upgrade.Schedule, signalledVersion uint64) (*app.App, keyring.Keyring) {
 	t.Helper()
 
 	db := dbm.NewMemDB()
@@ -193,7 +197,9 @@ func setupTestApp(t *testing.T, schedule upgrade.Schedule) (*app.App, keyring.Ke
 	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	upgradeSchedule := make(map[string]upgrade.Schedule)
 	upgradeSchedule[chainID] = schedule
-	testApp := app.New(log.NewNopLogger(), db, nil, true, 0, encCfg, upgradeSchedule, util.EmptyAppOptions{})
+	appOpts := testnode.DefaultAppOptions()
+	appOpts.Set(flags.FlagChainID, chainID)
+	testApp := app.New(log.NewNopLogger(), db, nil, true, 0, encCfg, upgradeSchedule, appOpts)
 
 	genesisState, _, kr := util.GenesisStateWithSingleValidator(testApp, "account")
 
@@ -225,6 +231,69 @@ func setupTestApp(t *testing.T, schedule upgrade.Schedule) (*app.App, keyring.Ke
 	// assert that the chain starts with version provided in genesis
 	require.EqualValues(t, app.DefaultConsensusParams().Version.AppVersion, testApp.GetBaseApp().AppVersion())
 
+	if signalledVersion != 0 {
+		ctx := testApp.NewContext(false, tmproto.Header{})
+		for _, validator := range testApp.StakingKeeper.GetAllValidators(ctx) {
+			testApp.UpgradeKeeper.SetVersionSignal(ctx, validator.GetOperator(), signalledVersion)
+		}
+	}
+
 	_ = testApp.Commit()
 	return testApp, kr
 }
+
+func TestScheduleQuery(t *testing.T) {
+	testApp, _ := setupTestApp(t, upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2)), 0)
+
+	// the schedule is served for the chain ID of the node
+	req, err := (&upgrade.QueryScheduleRequest{}).Marshal()
+	require.NoError(t, err)
+	resp := testApp.Query(abci.RequestQuery{Path: "/celestia.upgrade.v1.Query/Schedule", Data: req})
+	require.True(t, resp.IsOK(), resp.Log)
+	var schedule upgrade.QueryScheduleResponse
+	require.NoError(t, schedule.Unmarshal(resp.Value))
+	require.Equal(t, upgrade.QueryScheduleResponse{
+		ChainId:     testApp.GetChainID(),
+		Plans:       []upgrade.UpgradePlan{{Start: 3, End: 5, Version: 2}},
+		Height:      1,
+		AppVersion:  1,
+		NextVersion: 2,
+	}, schedule)
+
+	testCases := []struct {
+		height       int64
+		nextVersion  uint64
+		inPlanWindow bool
+	}{
+		{height: 2, nextVersion: 2, inPlanWindow: false},
+		{height: 3, nextVersion: 2, inPlanWindow: true},
+		{height: 5, nextVersion: 2, inPlanWindow: true},
+		{height: 6, nextVersion: 0, inPlanWindow: false},
+	}
+	for _, tc := range testCases {
+		ctx := testApp.NewContext(true, tmproto.Header{ChainID: testApp.GetChainID(), Height: tc.height})
+		schedule, err := testApp.UpgradeKeeper.Schedule(types.WrapSDKContext(ctx), &upgrade.QueryScheduleRequest{})
+		require.NoError(t, err)
+		require.Equal(t, tc.height, schedule.Height)
+		require.Equal(t, tc.nextVersion, schedule.NextVersion, tc.height)
+		require.Equal(t, tc.inPlanWindow, schedule.InPlanWindow, tc.height)
+	}
+
+	// the schedule is served even if the queried state has no chain ID, as
+	// after a restart until a block is committed
+	ctx := testApp.NewContext(true, tmproto.Header{Height: 4})
+	restartSchedule, err := testApp.UpgradeKeeper.Schedule(types.WrapSDKContext(ctx), &upgrade.QueryScheduleRequest{})
+	require.NoError(t, err)
+	require.Equal(t, testApp.GetChainID(), restartSchedule.ChainId)
+	require.Equal(t, []upgrade.UpgradePlan{{Start: 3, End: 5, Version: 2}}, restartSchedule.Plans)
+	require.True(t, restartSchedule.InPlanWindow)
+
+	// there is no schedule for other chains
+	otherApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0, encoding.MakeConfig(app.ModuleEncodingRegisters...),
+		map[string]upgrade.Schedule{"other_chain": upgrade.NewSchedule(upgrade.NewPlan(3, 5, 2))}, util.EmptyAppOptions{})
+	otherSchedule, err := otherApp.UpgradeKeeper.Schedule(types.WrapSDKContext(otherApp.NewContext(true, tmproto.Header{Height: 4})), &upgrade.QueryScheduleRequest{})
+	require.NoError(t, err)
+	require.Empty(t, otherSchedule.Plans)
+	require.Zero(t, otherSchedule.NextVersion)
+	require.False(t, otherSchedule.InPlanWindow)
+}